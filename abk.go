@@ -0,0 +1,36 @@
+package nmea
+
+const (
+	// TypeABK type for ABK sentences
+	TypeABK = "ABK"
+	// ChannelAABK AIS channel character
+	ChannelAABK = "A"
+	// ChannelBABK AIS channel character
+	ChannelBABK = "B"
+)
+
+// ABK is the AIS addressed and binary broadcast acknowledgement, sent by an AIS
+// unit after an ABM or BBM transmission.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_abk_ais_addressed_and_binary_broadcast_acknowledgement
+type ABK struct {
+	BaseSentence
+	MMSI            string // MMSI of the addressed AIS unit, empty for broadcasts
+	Channel         string // AIS channel of reception - A, B
+	MessageID       int64  // ITU-R M.1371 message ID
+	SequenceNumber  int64  // Message sequence number, 0 - 3
+	AcknowledgeType int64  // Type of acknowledgement - 0-successfully received, 1-received but not acknowledged, 2-could not be broadcast, 3-requested broadcast successfully transmitted, 4-late reception
+}
+
+// newABK constructor
+func newABK(s BaseSentence) (ABK, error) {
+	p := newParser(s)
+	p.AssertType(TypeABK)
+	return ABK{
+		BaseSentence:    s,
+		MMSI:            p.String(0, "MMSI"),
+		Channel:         p.EnumString(1, "channel", ChannelAABK, ChannelBABK),
+		MessageID:       p.Int64(2, "message ID"),
+		SequenceNumber:  p.Int64(3, "sequence number"),
+		AcknowledgeType: p.Int64(4, "acknowledgement type"),
+	}, p.Err()
+}
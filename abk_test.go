@@ -0,0 +1,62 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var abktests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  ABK
+}{
+	{
+		name: "good sentence",
+		raw:  "$AIABK,211000001,A,6,1,0*29",
+		msg: ABK{
+			MMSI:            "211000001",
+			Channel:         ChannelAABK,
+			MessageID:       6,
+			SequenceNumber:  1,
+			AcknowledgeType: 0,
+		},
+	},
+	{
+		name: "good broadcast sentence",
+		raw:  "$AIABK,,B,8,,3*25",
+		msg: ABK{
+			Channel:         ChannelBABK,
+			MessageID:       8,
+			AcknowledgeType: 3,
+		},
+	},
+	{
+		name: "invalid channel",
+		raw:  "$AIABK,211000001,C,6,1,0*2B",
+		err:  "nmea: AIABK invalid channel: C",
+	},
+	{
+		name: "invalid acknowledgement type",
+		raw:  "$AIABK,211000001,A,6,1,x*61",
+		err:  "nmea: AIABK invalid acknowledgement type: x",
+	},
+}
+
+func TestABK(t *testing.T) {
+	for _, tt := range abktests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				abk := m.(ABK)
+				abk.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, abk)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+const (
+	// TypeABM type for ABM sentences
+	TypeABM = "ABM"
+
+	// maxSentenceLength is the maximum length of a sentence including the line terminator.
+	maxSentenceLength = 82
+	// maxFragments is the maximum number of sentences an encapsulated message can span.
+	maxFragments = 9
+)
+
+// ABM is the AIS addressed binary and safety related message. It is sent to an
+// AIS unit to transmit a binary message to the addressed MMSI. Long payloads
+// are sent as a sequence of sentences, see ABMAggregator.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_abm_ais_addressed_binary_and_safety_related_message
+type ABM struct {
+	BaseSentence
+	NumFragments   int64  // Total number of sentences needed to transfer the message
+	FragmentNumber int64  // Sentence number
+	MessageID      int64  // Sequential message identifier, 0 - 3
+	MMSI           string // MMSI of the destination AIS unit
+	Channel        int64  // AIS channel for broadcast - 0-no preference, 1-A, 2-B, 3-both
+	ITUMessageID   int64  // ITU-R M.1371 message ID, 6 or 12
+	Payload        []byte // Encapsulated data bits
+}
+
+// newABM constructor
+func newABM(s BaseSentence) (ABM, error) {
+	p := newParser(s)
+	p.AssertType(TypeABM)
+	return ABM{
+		BaseSentence:   s,
+		NumFragments:   p.Int64(0, "number of fragments"),
+		FragmentNumber: p.Int64(1, "fragment number"),
+		MessageID:      p.Int64(2, "sequence number"),
+		MMSI:           p.String(3, "MMSI"),
+		Channel:        p.Int64(4, "channel"),
+		ITUMessageID:   p.Int64(5, "message ID"),
+		Payload:        p.SixBitASCIIArmour(6, int(p.Int64(7, "number of padding bits")), "payload"),
+	}, p.Err()
+}
+
+// Encode encodes the message into as many ABM sentences as are needed to
+// carry the payload. The talker, sequential message identifier, destination,
+// channel, message ID and payload are taken from m, the fragment fields are ignored.
+func (m ABM) Encode() ([]string, error) {
+	return encapsulate(m.Talker+TypeABM, m.Payload, func(total, number int) []string {
+		return []string{
+			strconv.Itoa(total),
+			strconv.Itoa(number),
+			strconv.FormatInt(m.MessageID, 10),
+			m.MMSI,
+			strconv.FormatInt(m.Channel, 10),
+			strconv.FormatInt(m.ITUMessageID, 10),
+		}
+	})
+}
+
+// ABMAggregator reassembles ABM messages that span multiple sentences.
+type ABMAggregator struct {
+	message ABM
+	seq     sequence
+}
+
+// Add adds the ABM sentence to the message being reassembled. Once the last
+// sentence of the sequence has been added, the first sentence with the
+// complete payload and true are returned.
+// A sentence with fragment number 1 always starts a new message.
+func (a *ABMAggregator) Add(m ABM) (ABM, bool, error) {
+	if m.FragmentNumber == 1 {
+		a.message = m
+		a.message.Payload = nil
+	}
+	done, err := a.seq.add(TypeABM, m.NumFragments, m.FragmentNumber)
+	if err == nil && (m.MessageID != a.message.MessageID || m.MMSI != a.message.MMSI) {
+		err = fmt.Errorf("nmea: ABM sentence %d does not belong to message %d to %s", m.FragmentNumber, a.message.MessageID, a.message.MMSI)
+	}
+	if err != nil {
+		a.Reset()
+		return ABM{}, false, err
+	}
+	a.message.Payload = append(a.message.Payload, m.Payload...)
+	if !done {
+		return ABM{}, false, nil
+	}
+	message := a.message
+	a.Reset()
+	return message, true, nil
+}
+
+// Reset discards the message being reassembled.
+func (a *ABMAggregator) Reset() {
+	*a = ABMAggregator{}
+}
+
+// encapsulate fragments the payload bits into encapsulated sentences with the
+// given prefix, keeping each sentence within the maximum sentence length.
+// The fields function returns the fields preceding the payload of each sentence.
+func encapsulate(prefix string, payload []byte, fields func(total, number int) []string) ([]string, error) {
+	data, fillBits := sixBitASCIIArmour(payload)
+
+	overhead := len(formatSentence(SentenceStartEncapsulated, prefix, append(fields(1, 1), "", "0"))) + 2
+	size := maxSentenceLength - overhead
+
+	var chunks []string
+	for len(data) > size {
+		chunks = append(chunks, data[:size])
+		data = data[size:]
+	}
+	chunks = append(chunks, data)
+	if len(chunks) > maxFragments {
+		return nil, fmt.Errorf("nmea: %s payload too long: %d bits", prefix, len(payload))
+	}
+
+	sentences := make([]string, len(chunks))
+	for i, chunk := range chunks {
+		fill := 0
+		if i == len(chunks)-1 {
+			fill = fillBits
+		}
+		fs := append(fields(len(chunks), i+1), chunk, strconv.Itoa(fill))
+		sentences[i] = formatSentence(SentenceStartEncapsulated, prefix, fs)
+	}
+	return sentences, nil
+}
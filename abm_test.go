@@ -0,0 +1,128 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var abmtests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  ABM
+}{
+	{
+		name: "good sentence",
+		raw:  "!AIABM,1,1,0,211000001,1,6,1@0,2*31",
+		msg: ABM{
+			NumFragments:   1,
+			FragmentNumber: 1,
+			MessageID:      0,
+			MMSI:           "211000001",
+			Channel:        1,
+			ITUMessageID:   6,
+			Payload:        []byte{0, 0, 0, 0, 0, 1, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0},
+		},
+	},
+	{
+		name: "invalid channel",
+		raw:  "!AIABM,1,1,0,211000001,x,6,1@0,2*78",
+		err:  "nmea: AIABM invalid channel: x",
+	},
+}
+
+func TestABM(t *testing.T) {
+	for _, tt := range abmtests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				abm := m.(ABM)
+				abm.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, abm)
+			}
+		})
+	}
+}
+
+func TestABMEncode(t *testing.T) {
+	m := ABM{
+		BaseSentence: BaseSentence{Talker: "AI"},
+		MMSI:         "211000001",
+		Channel:      1,
+		ITUMessageID: 6,
+		Payload:      []byte{0, 0, 0, 0, 0, 1, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0},
+	}
+	sentences, err := m.Encode()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"!AIABM,1,1,0,211000001,1,6,1@0,2*31"}, sentences)
+
+	m.MessageID = 2
+	m.Payload = make([]byte, 600)
+	for i := range m.Payload {
+		m.Payload[i] = byte(i % 3 % 2)
+	}
+	sentences, err = m.Encode()
+	assert.NoError(t, err)
+	assert.Len(t, sentences, 3)
+	var payload []byte
+	for i, raw := range sentences {
+		assert.True(t, len(raw)+2 <= maxSentenceLength, raw)
+		s, err := Parse(raw)
+		assert.NoError(t, err)
+		abm := s.(ABM)
+		assert.Equal(t, int64(3), abm.NumFragments)
+		assert.Equal(t, int64(i+1), abm.FragmentNumber)
+		assert.Equal(t, int64(2), abm.MessageID)
+		payload = append(payload, abm.Payload...)
+	}
+	assert.Equal(t, m.Payload, payload)
+
+	m.Payload = make([]byte, 6*50*maxFragments)
+	_, err = m.Encode()
+	assert.EqualError(t, err, "nmea: AIABM payload too long: 2700 bits")
+}
+
+func TestABMAggregator(t *testing.T) {
+	m := ABM{
+		BaseSentence: BaseSentence{Talker: "AI"},
+		MessageID:    1,
+		MMSI:         "211000001",
+		Channel:      1,
+		ITUMessageID: 6,
+		Payload:      make([]byte, 400),
+	}
+	for i := range m.Payload {
+		m.Payload[i] = byte(i % 5 % 2)
+	}
+	sentences, err := m.Encode()
+	assert.NoError(t, err)
+	assert.Len(t, sentences, 2)
+
+	var a ABMAggregator
+	s, err := Parse(sentences[0])
+	assert.NoError(t, err)
+	_, done, err := a.Add(s.(ABM))
+	assert.NoError(t, err)
+	assert.False(t, done)
+	s, err = Parse(sentences[1])
+	assert.NoError(t, err)
+	abm, done, err := a.Add(s.(ABM))
+	assert.NoError(t, err)
+	assert.True(t, done)
+	assert.Equal(t, m.Payload, abm.Payload)
+	assert.Equal(t, "211000001", abm.MMSI)
+	assert.Equal(t, int64(6), abm.ITUMessageID)
+	assert.Equal(t, int64(1), abm.FragmentNumber)
+
+	_, _, err = a.Add(ABM{NumFragments: 2, FragmentNumber: 1, MessageID: 1, MMSI: "211000001"})
+	assert.NoError(t, err)
+	_, _, err = a.Add(ABM{NumFragments: 2, FragmentNumber: 2, MessageID: 1, MMSI: "211000002"})
+	assert.EqualError(t, err, "nmea: ABM sentence 2 does not belong to message 1 to 211000001")
+	_, _, err = a.Add(ABM{NumFragments: 2, FragmentNumber: 2, MessageID: 1, MMSI: "211000001"})
+	assert.EqualError(t, err, "nmea: ABM unexpected sentence number: 2")
+}
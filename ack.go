@@ -0,0 +1,28 @@
+package nmea
+
+const (
+	// TypeACK type for ACK sentences
+	TypeACK = "ACK"
+)
+
+// ACK is the acknowledgement of an alarm raised by an ALR sentence.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_ack_alarm_acknowledgement
+type ACK struct {
+	BaseSentence
+	AlarmID int64 // Unique alarm number (identifier) at alarm source
+}
+
+// newACK constructor
+func newACK(s BaseSentence) (ACK, error) {
+	p := newParser(s)
+	p.AssertType(TypeACK)
+	return ACK{
+		BaseSentence: s,
+		AlarmID:      p.Int64(0, "alarm ID"),
+	}, p.Err()
+}
+
+// Acknowledges reports whether the sentence acknowledges the given alarm.
+func (m ACK) Acknowledges(a ALR) bool {
+	return m.AlarmID == a.AlarmID
+}
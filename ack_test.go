@@ -0,0 +1,53 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var acktests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  ACK
+}{
+	{
+		name: "good sentence",
+		raw:  "$IIACK,031*57",
+		msg: ACK{
+			AlarmID: 31,
+		},
+	},
+	{
+		name: "invalid alarm ID",
+		raw:  "$IIACK,x31*1F",
+		err:  "nmea: IIACK invalid alarm ID: x31",
+	},
+}
+
+func TestACK(t *testing.T) {
+	for _, tt := range acktests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				ack := m.(ACK)
+				ack.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, ack)
+			}
+		})
+	}
+}
+
+func TestACKAcknowledges(t *testing.T) {
+	alr, err := Parse("$IIALR,020000,031,A,V,Echo sounder alarm*72")
+	assert.NoError(t, err)
+	ack, err := Parse("$IIACK,031*57")
+	assert.NoError(t, err)
+	assert.True(t, ack.(ACK).Acknowledges(alr.(ALR)))
+	assert.False(t, ACK{AlarmID: 32}.Acknowledges(alr.(ALR)))
+}
@@ -0,0 +1,51 @@
+package nmea
+
+const (
+	// TypeAIR type for AIR sentences
+	TypeAIR = "AIR"
+)
+
+// AIR is the AIS interrogation request, used to request up to two messages from
+// one station, or one message from each of two stations. The channel uses the
+// same characters as ABK.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_air_ais_interrogation_request
+type AIR struct {
+	BaseSentence
+	Station1MMSI        string // MMSI of the first interrogated station
+	Station1Message1    int64  // First ITU-R M.1371 message ID requested from the first station
+	Station1Subsection1 int64  // Message sub-section of the first message requested from the first station
+	Station1Message2    int64  // Second ITU-R M.1371 message ID requested from the first station
+	Station1Subsection2 int64  // Message sub-section of the second message requested from the first station
+	Station2MMSI        string // MMSI of the second interrogated station
+	Station2Message     int64  // ITU-R M.1371 message ID requested from the second station
+	Station2Subsection  int64  // Message sub-section of the message requested from the second station
+	Channel             string // Channel of interrogation - A, B
+	Station1Reply1Slot  int64  // Reply slot for the first message of the first station (NMEA 4.0 and later)
+	Station1Reply2Slot  int64  // Reply slot for the second message of the first station (NMEA 4.0 and later)
+	Station2ReplySlot   int64  // Reply slot for the message of the second station (NMEA 4.0 and later)
+}
+
+// newAIR constructor
+func newAIR(s BaseSentence) (AIR, error) {
+	p := newParser(s)
+	p.AssertType(TypeAIR)
+	p.AssertFieldCount(9, 12)
+	m := AIR{
+		BaseSentence:        s,
+		Station1MMSI:        p.String(0, "station 1 MMSI"),
+		Station1Message1:    p.Int64(1, "station 1 message 1 ID"),
+		Station1Subsection1: p.Int64(2, "station 1 message 1 sub-section"),
+		Station1Message2:    p.Int64(3, "station 1 message 2 ID"),
+		Station1Subsection2: p.Int64(4, "station 1 message 2 sub-section"),
+		Station2MMSI:        p.String(5, "station 2 MMSI"),
+		Station2Message:     p.Int64(6, "station 2 message ID"),
+		Station2Subsection:  p.Int64(7, "station 2 message sub-section"),
+		Channel:             p.EnumString(8, "channel", ChannelAABK, ChannelBABK),
+	}
+	if len(m.Fields) > 9 {
+		m.Station1Reply1Slot = p.Int64(9, "station 1 message 1 reply slot")
+		m.Station1Reply2Slot = p.Int64(10, "station 1 message 2 reply slot")
+		m.Station2ReplySlot = p.Int64(11, "station 2 message reply slot")
+	}
+	return m, p.Err()
+}
@@ -0,0 +1,63 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var airtests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  AIR
+}{
+	{
+		name: "good sentence",
+		raw:  "$AIAIR,211000001,3,,5,,219000001,3,,A,,,*2E",
+		msg: AIR{
+			Station1MMSI:     "211000001",
+			Station1Message1: 3,
+			Station1Message2: 5,
+			Station2MMSI:     "219000001",
+			Station2Message:  3,
+			Channel:          ChannelAABK,
+		},
+	},
+	{
+		name: "good sentence without reply slots",
+		raw:  "$AIAIR,211000001,3,,,,,,,B*3C",
+		msg: AIR{
+			Station1MMSI:     "211000001",
+			Station1Message1: 3,
+			Channel:          ChannelBABK,
+		},
+	},
+	{
+		name: "invalid channel",
+		raw:  "$AIAIR,211000001,3,,5,,219000001,3,,C,,,*2C",
+		err:  "nmea: AIAIR invalid channel: C",
+	},
+	{
+		name: "invalid message ID",
+		raw:  "$AIAIR,211000001,x,,5,,219000001,3,,A,,,*65",
+		err:  "nmea: AIAIR invalid station 1 message 1 ID: x",
+	},
+}
+
+func TestAIR(t *testing.T) {
+	for _, tt := range airtests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				air := m.(AIR)
+				air.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, air)
+			}
+		})
+	}
+}
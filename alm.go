@@ -0,0 +1,52 @@
+package nmea
+
+const (
+	// TypeALM type for ALM sentences
+	TypeALM = "ALM"
+)
+
+// ALM is the GPS almanac data of a single satellite.
+// The orbital parameters are the raw values sent in hexadecimal.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_alm_gps_almanac_data
+type ALM struct {
+	BaseSentence
+	NumberOfSentences        int64 // Total number of sentences
+	SentenceNumber           int64 // Sentence number
+	PRNNumber                int64 // Satellite PRN number, 01 to 32
+	WeekNumber               int64 // GPS week number
+	SVHealth                 int64 // SV health, bits 17-24 of each almanac page
+	Eccentricity             int64 // Eccentricity
+	AlmanacReferenceTime     int64 // Almanac reference time
+	InclinationAngle         int64 // Inclination angle
+	RateOfRightAscension     int64 // Rate of right ascension
+	RootOfSemiMajorAxis      int64 // Root of semi-major axis
+	ArgumentOfPerigee        int64 // Argument of perigee
+	LongitudeOfAscensionNode int64 // Longitude of ascension node
+	MeanAnomaly              int64 // Mean anomaly
+	F0ClockParameter         int64 // F0 clock parameter
+	F1ClockParameter         int64 // F1 clock parameter
+}
+
+// newALM constructor
+func newALM(s BaseSentence) (ALM, error) {
+	p := newParser(s)
+	p.AssertType(TypeALM)
+	return ALM{
+		BaseSentence:             s,
+		NumberOfSentences:        p.Int64(0, "number of sentences"),
+		SentenceNumber:           p.Int64(1, "sentence number"),
+		PRNNumber:                p.Int64(2, "PRN number"),
+		WeekNumber:               p.Int64(3, "week number"),
+		SVHealth:                 p.HexInt64(4, "SV health"),
+		Eccentricity:             p.HexInt64(5, "eccentricity"),
+		AlmanacReferenceTime:     p.HexInt64(6, "almanac reference time"),
+		InclinationAngle:         p.HexInt64(7, "inclination angle"),
+		RateOfRightAscension:     p.HexInt64(8, "rate of right ascension"),
+		RootOfSemiMajorAxis:      p.HexInt64(9, "root of semi-major axis"),
+		ArgumentOfPerigee:        p.HexInt64(10, "argument of perigee"),
+		LongitudeOfAscensionNode: p.HexInt64(11, "longitude of ascension node"),
+		MeanAnomaly:              p.HexInt64(12, "mean anomaly"),
+		F0ClockParameter:         p.HexInt64(13, "F0 clock parameter"),
+		F1ClockParameter:         p.HexInt64(14, "F1 clock parameter"),
+	}, p.Err()
+}
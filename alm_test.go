@@ -0,0 +1,63 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var almtests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  ALM
+}{
+	{
+		name: "good sentence",
+		raw:  "$GPALM,1,1,15,1159,00,441d,4e,16be,fd5e,a10c9f,4a2da4,686e81,58cbe1,0a4,001*77",
+		msg: ALM{
+			NumberOfSentences:        1,
+			SentenceNumber:           1,
+			PRNNumber:                15,
+			WeekNumber:               1159,
+			SVHealth:                 0,
+			Eccentricity:             0x441d,
+			AlmanacReferenceTime:     0x4e,
+			InclinationAngle:         0x16be,
+			RateOfRightAscension:     0xfd5e,
+			RootOfSemiMajorAxis:      0xa10c9f,
+			ArgumentOfPerigee:        0x4a2da4,
+			LongitudeOfAscensionNode: 0x686e81,
+			MeanAnomaly:              0x58cbe1,
+			F0ClockParameter:         0x0a4,
+			F1ClockParameter:         0x001,
+		},
+	},
+	{
+		name: "invalid PRN number",
+		raw:  "$GPALM,1,1,x5,1159,00,441d,4e,16be,fd5e,a10c9f,4a2da4,686e81,58cbe1,0a4,001*3E",
+		err:  "nmea: GPALM invalid PRN number: x5",
+	},
+	{
+		name: "invalid eccentricity",
+		raw:  "$GPALM,1,1,15,1159,00,441x,4e,16be,fd5e,a10c9f,4a2da4,686e81,58cbe1,0a4,001*6B",
+		err:  "nmea: GPALM invalid eccentricity: 441x",
+	},
+}
+
+func TestALM(t *testing.T) {
+	for _, tt := range almtests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				alm := m.(ALM)
+				alm.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, alm)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package nmea
+
+const (
+	// TypeALR type for ALR sentences
+	TypeALR = "ALR"
+	// ThresholdExceededALR alarm condition character
+	ThresholdExceededALR = "A"
+	// ThresholdNotExceededALR alarm condition character
+	ThresholdNotExceededALR = "V"
+	// AcknowledgedALR alarm acknowledge state character
+	AcknowledgedALR = "A"
+	// UnacknowledgedALR alarm acknowledge state character
+	UnacknowledgedALR = "V"
+)
+
+// ALR is the local alarm condition and status.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_alr_set_alarm_state
+type ALR struct {
+	BaseSentence
+	Time        Time   // Time of alarm condition change, UTC
+	AlarmID     int64  // Unique alarm number (identifier) at alarm source
+	Condition   string // Alarm condition - A-threshold exceeded, V-not exceeded
+	State       string // Alarm acknowledge state - A-acknowledged, V-unacknowledged
+	Description string // Alarm description text
+}
+
+// newALR constructor
+func newALR(s BaseSentence) (ALR, error) {
+	p := newParser(s)
+	p.AssertType(TypeALR)
+	return ALR{
+		BaseSentence: s,
+		Time:         p.Time(0, "time"),
+		AlarmID:      p.Int64(1, "alarm ID"),
+		Condition:    p.EnumString(2, "alarm condition", ThresholdExceededALR, ThresholdNotExceededALR),
+		State:        p.EnumString(3, "alarm state", AcknowledgedALR, UnacknowledgedALR),
+		Description:  p.Text(4, "description"),
+	}, p.Err()
+}
@@ -0,0 +1,58 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var alrtests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  ALR
+}{
+	{
+		name: "good sentence",
+		raw:  "$IIALR,020000,031,A,V,Echo sounder alarm*72",
+		msg: ALR{
+			Time:        Time{true, 2, 0, 0, 0, 0},
+			AlarmID:     31,
+			Condition:   ThresholdExceededALR,
+			State:       UnacknowledgedALR,
+			Description: "Echo sounder alarm",
+		},
+	},
+	{
+		name: "invalid alarm ID",
+		raw:  "$IIALR,020000,x31,A,V,Echo sounder alarm*3A",
+		err:  "nmea: IIALR invalid alarm ID: x31",
+	},
+	{
+		name: "invalid alarm condition",
+		raw:  "$IIALR,020000,031,X,V,Echo sounder alarm*6B",
+		err:  "nmea: IIALR invalid alarm condition: X",
+	},
+	{
+		name: "invalid alarm state",
+		raw:  "$IIALR,020000,031,A,X,Echo sounder alarm*7C",
+		err:  "nmea: IIALR invalid alarm state: X",
+	},
+}
+
+func TestALR(t *testing.T) {
+	for _, tt := range alrtests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				alr := m.(ALR)
+				alr.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, alr)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+const (
+	// TypeBBM type for BBM sentences
+	TypeBBM = "BBM"
+)
+
+// BBM is the AIS broadcast binary message. It is sent to an AIS unit to
+// broadcast a binary message. Long payloads are sent as a sequence of
+// sentences, see BBMAggregator.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_bbm_ais_broadcast_binary_message
+type BBM struct {
+	BaseSentence
+	NumFragments   int64  // Total number of sentences needed to transfer the message
+	FragmentNumber int64  // Sentence number
+	MessageID      int64  // Sequential message identifier, 0 - 9
+	Channel        int64  // AIS channel for broadcast - 0-no preference, 1-A, 2-B, 3-both
+	ITUMessageID   int64  // ITU-R M.1371 message ID, 8 or 14
+	Payload        []byte // Encapsulated data bits
+}
+
+// newBBM constructor
+func newBBM(s BaseSentence) (BBM, error) {
+	p := newParser(s)
+	p.AssertType(TypeBBM)
+	return BBM{
+		BaseSentence:   s,
+		NumFragments:   p.Int64(0, "number of fragments"),
+		FragmentNumber: p.Int64(1, "fragment number"),
+		MessageID:      p.Int64(2, "sequence number"),
+		Channel:        p.Int64(3, "channel"),
+		ITUMessageID:   p.Int64(4, "message ID"),
+		Payload:        p.SixBitASCIIArmour(5, int(p.Int64(6, "number of padding bits")), "payload"),
+	}, p.Err()
+}
+
+// Encode encodes the message into as many BBM sentences as are needed to
+// carry the payload. The talker, sequential message identifier, channel,
+// message ID and payload are taken from m, the fragment fields are ignored.
+func (m BBM) Encode() ([]string, error) {
+	return encapsulate(m.Talker+TypeBBM, m.Payload, func(total, number int) []string {
+		return []string{
+			strconv.Itoa(total),
+			strconv.Itoa(number),
+			strconv.FormatInt(m.MessageID, 10),
+			strconv.FormatInt(m.Channel, 10),
+			strconv.FormatInt(m.ITUMessageID, 10),
+		}
+	})
+}
+
+// BBMAggregator reassembles BBM messages that span multiple sentences.
+type BBMAggregator struct {
+	message BBM
+	seq     sequence
+}
+
+// Add adds the BBM sentence to the message being reassembled. Once the last
+// sentence of the sequence has been added, the first sentence with the
+// complete payload and true are returned.
+// A sentence with fragment number 1 always starts a new message.
+func (a *BBMAggregator) Add(m BBM) (BBM, bool, error) {
+	if m.FragmentNumber == 1 {
+		a.message = m
+		a.message.Payload = nil
+	}
+	done, err := a.seq.add(TypeBBM, m.NumFragments, m.FragmentNumber)
+	if err == nil && m.MessageID != a.message.MessageID {
+		err = fmt.Errorf("nmea: BBM sentence %d does not belong to message %d", m.FragmentNumber, a.message.MessageID)
+	}
+	if err != nil {
+		a.Reset()
+		return BBM{}, false, err
+	}
+	a.message.Payload = append(a.message.Payload, m.Payload...)
+	if !done {
+		return BBM{}, false, nil
+	}
+	message := a.message
+	a.Reset()
+	return message, true, nil
+}
+
+// Reset discards the message being reassembled.
+func (a *BBMAggregator) Reset() {
+	*a = BBMAggregator{}
+}
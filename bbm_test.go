@@ -0,0 +1,97 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var bbmtests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  BBM
+}{
+	{
+		name: "good sentence",
+		raw:  "!AIBBM,1,1,3,0,8,1@0,2*21",
+		msg: BBM{
+			NumFragments:   1,
+			FragmentNumber: 1,
+			MessageID:      3,
+			Channel:        0,
+			ITUMessageID:   8,
+			Payload:        []byte{0, 0, 0, 0, 0, 1, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0},
+		},
+	},
+	{
+		name: "invalid checksum",
+		raw:  "!AIBBM,1,1,3,0,8,1@0,2*22",
+		err:  "nmea: sentence checksum mismatch [21 != 22]",
+	},
+}
+
+func TestBBM(t *testing.T) {
+	for _, tt := range bbmtests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				bbm := m.(BBM)
+				bbm.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, bbm)
+			}
+		})
+	}
+}
+
+func TestBBMEncode(t *testing.T) {
+	m := BBM{
+		BaseSentence: BaseSentence{Talker: "AI"},
+		MessageID:    3,
+		ITUMessageID: 8,
+		Payload:      []byte{0, 0, 0, 0, 0, 1, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0},
+	}
+	sentences, err := m.Encode()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"!AIBBM,1,1,3,0,8,1@0,2*21"}, sentences)
+}
+
+func TestBBMAggregator(t *testing.T) {
+	m := BBM{
+		BaseSentence: BaseSentence{Talker: "AI"},
+		MessageID:    3,
+		Channel:      3,
+		ITUMessageID: 8,
+		Payload:      make([]byte, 400),
+	}
+	for i := range m.Payload {
+		m.Payload[i] = byte(i % 7 % 2)
+	}
+	sentences, err := m.Encode()
+	assert.NoError(t, err)
+	assert.Len(t, sentences, 2)
+
+	var a BBMAggregator
+	s, err := Parse(sentences[0])
+	assert.NoError(t, err)
+	_, done, err := a.Add(s.(BBM))
+	assert.NoError(t, err)
+	assert.False(t, done)
+	s, err = Parse(sentences[1])
+	assert.NoError(t, err)
+	bbm, done, err := a.Add(s.(BBM))
+	assert.NoError(t, err)
+	assert.True(t, done)
+	assert.Equal(t, m.Payload, bbm.Payload)
+	assert.Equal(t, int64(3), bbm.Channel)
+	assert.Equal(t, int64(8), bbm.ITUMessageID)
+
+	_, _, err = a.Add(BBM{NumFragments: 2, FragmentNumber: 1, MessageID: 3})
+	assert.NoError(t, err)
+	_, _, err = a.Add(BBM{NumFragments: 2, FragmentNumber: 2, MessageID: 4})
+	assert.EqualError(t, err, "nmea: BBM sentence 2 does not belong to message 3")
+}
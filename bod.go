@@ -0,0 +1,36 @@
+package nmea
+
+const (
+	// TypeBOD type for BOD sentences
+	TypeBOD = "BOD"
+)
+
+// BOD is the bearing from the origin waypoint to the destination waypoint.
+// http://aprs.gids.nl/nmea/#bod
+type BOD struct {
+	BaseSentence
+	BearingTrue           float64 // Bearing in degrees relative to true north
+	BearingMagnetic       float64 // Bearing in degrees relative to magnetic north
+	DestinationWaypointID string  // Destination waypoint ID
+	OriginWaypointID      string  // Origin waypoint ID
+}
+
+// newBOD constructor
+func newBOD(s BaseSentence) (BOD, error) {
+	p := newParser(s)
+	p.AssertType(TypeBOD)
+
+	bearingTrue := p.Float64(0, "true bearing")
+	_ = p.EnumString(1, "true bearing unit", BearingTrue)
+
+	bearingMagnetic := p.Float64(2, "magnetic bearing")
+	_ = p.EnumString(3, "magnetic bearing unit", BearingMagnetic)
+
+	return BOD{
+		BaseSentence:          s,
+		BearingTrue:           bearingTrue,
+		BearingMagnetic:       bearingMagnetic,
+		DestinationWaypointID: p.String(4, "destination waypoint ID"),
+		OriginWaypointID:      p.String(5, "origin waypoint ID"),
+	}, p.Err()
+}
@@ -0,0 +1,72 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var bodtests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  BOD
+}{
+	{
+		name: "good sentence",
+		raw:  "$GPBOD,099.3,T,105.6,M,POINTB,POINTA*45",
+		msg: BOD{
+			BearingTrue:           99.3,
+			BearingMagnetic:       105.6,
+			DestinationWaypointID: "POINTB",
+			OriginWaypointID:      "POINTA",
+		},
+	},
+	{
+		name: "no origin waypoint",
+		raw:  "$GPBOD,097.0,T,103.2,M,POINTB,*47",
+		msg: BOD{
+			BearingTrue:           97.0,
+			BearingMagnetic:       103.2,
+			DestinationWaypointID: "POINTB",
+			OriginWaypointID:      "",
+		},
+	},
+	{
+		name: "invalid true bearing",
+		raw:  "$GPBOD,x99.3,T,105.6,M,POINTB,POINTA*0D",
+		err:  "nmea: GPBOD invalid true bearing: x99.3",
+	},
+	{
+		name: "invalid true bearing unit",
+		raw:  "$GPBOD,099.3,X,105.6,M,POINTB,POINTA*49",
+		err:  "nmea: GPBOD invalid true bearing unit: X",
+	},
+	{
+		name: "invalid magnetic bearing",
+		raw:  "$GPBOD,099.3,T,x05.6,M,POINTB,POINTA*0C",
+		err:  "nmea: GPBOD invalid magnetic bearing: x05.6",
+	},
+	{
+		name: "invalid magnetic bearing unit",
+		raw:  "$GPBOD,099.3,T,105.6,X,POINTB,POINTA*50",
+		err:  "nmea: GPBOD invalid magnetic bearing unit: X",
+	},
+}
+
+func TestBOD(t *testing.T) {
+	for _, tt := range bodtests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				bod := m.(BOD)
+				bod.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, bod)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package nmea
+
+const (
+	// TypeBWC type for BWC sentences
+	TypeBWC = "BWC"
+)
+
+// BWC is the bearing and distance to a waypoint along the great circle.
+// http://aprs.gids.nl/nmea/#bwc
+type BWC struct {
+	BaseSentence
+	Time            Time      // UTC time of fix
+	Latitude        Latitude  // Waypoint latitude
+	Longitude       Longitude // Waypoint longitude
+	BearingTrue     float64   // Bearing in degrees relative to true north
+	BearingMagnetic float64   // Bearing in degrees relative to magnetic north
+	Distance        Distance  // Distance to waypoint
+	WaypointID      string    // Waypoint ID
+	FAAMode         string    // FAA mode indicator (NMEA 2.3 and later)
+}
+
+// newBWC constructor
+func newBWC(s BaseSentence) (BWC, error) {
+	p := newParser(s)
+	p.AssertType(TypeBWC)
+	p.AssertFieldCount(12, 13)
+	return parseBWC(p), p.Err()
+}
+
+// parseBWC parses the field layout shared by the BWC and BWR sentences.
+func parseBWC(p *parser) BWC {
+	time := p.Time(0, "time")
+	latitude := p.Latitude(1, 2, "latitude")
+	longitude := p.Longitude(3, 4, "longitude")
+
+	bearingTrue := p.Float64(5, "true bearing")
+	_ = p.EnumString(6, "true bearing unit", BearingTrue)
+
+	bearingMagnetic := p.Float64(7, "magnetic bearing")
+	_ = p.EnumString(8, "magnetic bearing unit", BearingMagnetic)
+
+	distance := p.Float64(9, "distance")
+	_ = p.EnumString(10, "distance unit", DistanceNauticalMiles)
+
+	m := BWC{
+		BaseSentence:    p.BaseSentence,
+		Time:            time,
+		Latitude:        latitude,
+		Longitude:       longitude,
+		BearingTrue:     bearingTrue,
+		BearingMagnetic: bearingMagnetic,
+		Distance:        DistanceFromNauticalMiles(distance),
+		WaypointID:      p.String(11, "waypoint ID"),
+	}
+	if len(m.Fields) > 12 {
+		m.FAAMode = p.EnumString(12, "FAA mode", FAAModeAutonomous, FAAModeDifferential, FAAModeEstimated, FAAModeManual, FAAModeSimulator, FAAModeNotValid, FAAModePrecise)
+	}
+	return m
+}
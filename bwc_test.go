@@ -0,0 +1,84 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var bwctests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  BWC
+}{
+	{
+		name: "good sentence",
+		raw:  "$GPBWC,220516,5130.02,N,00046.34,W,213.8,T,218.0,M,0004.6,N,EGLM*21",
+		msg: BWC{
+			Time:            Time{true, 22, 5, 16, 0, 0},
+			Latitude:        Latitude(MustParseGPS("5130.02 N")),
+			Longitude:       Longitude(MustParseGPS("00046.34 W")),
+			BearingTrue:     213.8,
+			BearingMagnetic: 218.0,
+			Distance:        DistanceFromNauticalMiles(4.6),
+			WaypointID:      "EGLM",
+		},
+	},
+	{
+		name: "good sentence with FAA mode",
+		raw:  "$GPBWC,220516,5130.02,N,00046.34,W,213.8,T,218.0,M,0004.6,N,EGLM,D*49",
+		msg: BWC{
+			Time:            Time{true, 22, 5, 16, 0, 0},
+			Latitude:        Latitude(MustParseGPS("5130.02 N")),
+			Longitude:       Longitude(MustParseGPS("00046.34 W")),
+			BearingTrue:     213.8,
+			BearingMagnetic: 218.0,
+			Distance:        DistanceFromNauticalMiles(4.6),
+			WaypointID:      "EGLM",
+			FAAMode:         FAAModeDifferential,
+		},
+	},
+	{
+		name: "invalid time",
+		raw:  "$GPBWC,2205X6,5130.02,N,00046.34,W,213.8,T,218.0,M,0004.6,N,EGLM,A*25",
+		err:  "nmea: GPBWC invalid time: 2205X6",
+	},
+	{
+		name: "invalid latitude",
+		raw:  "$GPBWC,220516,51x0.02,N,00046.34,W,213.8,T,218.0,M,0004.6,N,EGLM,A*07",
+		err:  "nmea: GPBWC invalid latitude: cannot parse [51x0.02 N], unknown format",
+	},
+	{
+		name: "invalid distance",
+		raw:  "$GPBWC,220516,5130.02,N,00046.34,W,213.8,T,218.0,M,00x4.6,N,EGLM,A*04",
+		err:  "nmea: GPBWC invalid distance: 00x4.6",
+	},
+	{
+		name: "invalid distance unit",
+		raw:  "$GPBWC,220516,5130.02,N,00046.34,W,213.8,T,218.0,M,0004.6,K,EGLM,A*49",
+		err:  "nmea: GPBWC invalid distance unit: K",
+	},
+	{
+		name: "invalid FAA mode",
+		raw:  "$GPBWC,220516,5130.02,N,00046.34,W,213.8,T,218.0,M,0004.6,N,EGLM,X*55",
+		err:  "nmea: GPBWC invalid FAA mode: X",
+	},
+}
+
+func TestBWC(t *testing.T) {
+	for _, tt := range bwctests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				bwc := m.(BWC)
+				bwc.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, bwc)
+			}
+		})
+	}
+}
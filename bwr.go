@@ -0,0 +1,19 @@
+package nmea
+
+const (
+	// TypeBWR type for BWR sentences
+	TypeBWR = "BWR"
+)
+
+// BWR is the bearing and distance to a waypoint along the rhumb line.
+// It has the same fields as BWC.
+// http://aprs.gids.nl/nmea/#bwr
+type BWR BWC
+
+// newBWR constructor
+func newBWR(s BaseSentence) (BWR, error) {
+	p := newParser(s)
+	p.AssertType(TypeBWR)
+	p.AssertFieldCount(12, 13)
+	return BWR(parseBWC(p)), p.Err()
+}
@@ -0,0 +1,69 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var bwrtests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  BWR
+}{
+	{
+		name: "good sentence",
+		raw:  "$GPBWR,220516,5130.02,N,00046.34,W,213.8,T,218.0,M,0004.6,N,EGLM*30",
+		msg: BWR{
+			Time:            Time{true, 22, 5, 16, 0, 0},
+			Latitude:        Latitude(MustParseGPS("5130.02 N")),
+			Longitude:       Longitude(MustParseGPS("00046.34 W")),
+			BearingTrue:     213.8,
+			BearingMagnetic: 218.0,
+			Distance:        DistanceFromNauticalMiles(4.6),
+			WaypointID:      "EGLM",
+		},
+	},
+	{
+		name: "good sentence with FAA mode",
+		raw:  "$GPBWR,220516,5130.02,N,00046.34,W,213.8,T,218.0,M,0004.6,N,EGLM,A*5D",
+		msg: BWR{
+			Time:            Time{true, 22, 5, 16, 0, 0},
+			Latitude:        Latitude(MustParseGPS("5130.02 N")),
+			Longitude:       Longitude(MustParseGPS("00046.34 W")),
+			BearingTrue:     213.8,
+			BearingMagnetic: 218.0,
+			Distance:        DistanceFromNauticalMiles(4.6),
+			WaypointID:      "EGLM",
+			FAAMode:         FAAModeAutonomous,
+		},
+	},
+	{
+		name: "invalid true bearing",
+		raw:  "$GPBWR,220516,5130.02,N,00046.34,W,2x3.8,T,218.0,M,0004.6,N,EGLM,A*14",
+		err:  "nmea: GPBWR invalid true bearing: 2x3.8",
+	},
+	{
+		name: "invalid magnetic bearing unit",
+		raw:  "$GPBWR,220516,5130.02,N,00046.34,W,213.8,T,218.0,X,0004.6,N,EGLM,A*48",
+		err:  "nmea: GPBWR invalid magnetic bearing unit: X",
+	},
+}
+
+func TestBWR(t *testing.T) {
+	for _, tt := range bwrtests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				bwr := m.(BWR)
+				bwr.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, bwr)
+			}
+		})
+	}
+}
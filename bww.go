@@ -0,0 +1,36 @@
+package nmea
+
+const (
+	// TypeBWW type for BWW sentences
+	TypeBWW = "BWW"
+)
+
+// BWW is the bearing from one waypoint to another.
+// http://aprs.gids.nl/nmea/#bww
+type BWW struct {
+	BaseSentence
+	BearingTrue     float64 // Bearing in degrees relative to true north
+	BearingMagnetic float64 // Bearing in degrees relative to magnetic north
+	ToWaypointID    string  // TO waypoint ID
+	FromWaypointID  string  // FROM waypoint ID
+}
+
+// newBWW constructor
+func newBWW(s BaseSentence) (BWW, error) {
+	p := newParser(s)
+	p.AssertType(TypeBWW)
+
+	bearingTrue := p.Float64(0, "true bearing")
+	_ = p.EnumString(1, "true bearing unit", BearingTrue)
+
+	bearingMagnetic := p.Float64(2, "magnetic bearing")
+	_ = p.EnumString(3, "magnetic bearing unit", BearingMagnetic)
+
+	return BWW{
+		BaseSentence:    s,
+		BearingTrue:     bearingTrue,
+		BearingMagnetic: bearingMagnetic,
+		ToWaypointID:    p.String(4, "to waypoint ID"),
+		FromWaypointID:  p.String(5, "from waypoint ID"),
+	}, p.Err()
+}
@@ -0,0 +1,52 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var bwwtests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  BWW
+}{
+	{
+		name: "good sentence",
+		raw:  "$GPBWW,097.0,T,103.2,M,POINTB,POINTA*41",
+		msg: BWW{
+			BearingTrue:     97.0,
+			BearingMagnetic: 103.2,
+			ToWaypointID:    "POINTB",
+			FromWaypointID:  "POINTA",
+		},
+	},
+	{
+		name: "invalid true bearing unit",
+		raw:  "$GPBWW,097.0,X,103.2,M,POINTB,POINTA*4D",
+		err:  "nmea: GPBWW invalid true bearing unit: X",
+	},
+	{
+		name: "invalid magnetic bearing",
+		raw:  "$GPBWW,097.0,T,x03.2,M,POINTB,POINTA*08",
+		err:  "nmea: GPBWW invalid magnetic bearing: x03.2",
+	},
+}
+
+func TestBWW(t *testing.T) {
+	for _, tt := range bwwtests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				bww := m.(BWW)
+				bww.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, bww)
+			}
+		})
+	}
+}
@@ -112,8 +112,8 @@ var gnggatests = []struct {
 				Second:      15,
 				Millisecond: 0,
 			},
-			Latitude:      MustParseLatLong("6325.6138 N"),
-			Longitude:     MustParseLatLong("01021.4290 E"),
+			Latitude:      Latitude(MustParseLatLong("6325.6138 N")),
+			Longitude:     Longitude(MustParseLatLong("01021.4290 E")),
 			FixQuality:    "1",
 			NumSatellites: 8,
 			HDOP:          2.42,
@@ -167,9 +167,9 @@ var gngnstests = []struct {
 		name: "good sentence A",
 		raw:  "$GNGNS,014035.00,4332.69262,S,17235.48549,E,RR,13,0.9,25.63,11.24,,*70",
 		msg: GNGNS{
-			Time:       Time{true, 1, 40, 35, 0},
-			Latitude:   MustParseGPS("4332.69262 S"),
-			Longitude:  MustParseGPS("17235.48549 E"),
+			Time:       Time{true, 1, 40, 35, 0, 0},
+			Latitude:   Latitude(MustParseGPS("4332.69262 S")),
+			Longitude:  Longitude(MustParseGPS("17235.48549 E")),
 			Mode:       []string{"R", "R"},
 			SVs:        13,
 			HDOP:       0.9,
@@ -183,9 +183,9 @@ var gngnstests = []struct {
 		name: "good sentence B",
 		raw:  "$GNGNS,094821.0,4849.931307,N,00216.053323,E,AA,14,0.6,161.5,48.0,,*6D",
 		msg: GNGNS{
-			Time:       Time{true, 9, 48, 21, 0},
-			Latitude:   MustParseGPS("4849.931307 N"),
-			Longitude:  MustParseGPS("00216.053323 E"),
+			Time:       Time{true, 9, 48, 21, 0, 0},
+			Latitude:   Latitude(MustParseGPS("4849.931307 N")),
+			Longitude:  Longitude(MustParseGPS("00216.053323 E")),
 			Mode:       []string{"A", "A"},
 			SVs:        14,
 			HDOP:       0.6,
@@ -199,9 +199,9 @@ var gngnstests = []struct {
 		name: "good sentence B",
 		raw:  "$GNGNS,094821.0,4849.931307,N,00216.053323,E,AAN,14,0.6,161.5,48.0,,*23",
 		msg: GNGNS{
-			Time:       Time{true, 9, 48, 21, 0},
-			Latitude:   MustParseGPS("4849.931307 N"),
-			Longitude:  MustParseGPS("00216.053323 E"),
+			Time:       Time{true, 9, 48, 21, 0, 0},
+			Latitude:   Latitude(MustParseGPS("4849.931307 N")),
+			Longitude:  Longitude(MustParseGPS("00216.053323 E")),
 			Mode:       []string{"A", "A", "N"},
 			SVs:        14,
 			HDOP:       0.6,
@@ -245,42 +245,42 @@ var gnrmctests = []struct {
 		name: "good sentence A",
 		raw:  "$GNRMC,220516,A,5133.82,N,00042.24,W,173.8,231.8,130694,004.2,W*6E",
 		msg: GNRMC{
-			Time:      Time{true, 22, 05, 16, 0},
+			Time:      Time{true, 22, 05, 16, 0, 0},
 			Validity:  "A",
 			Speed:     173.8,
 			Course:    231.8,
 			Date:      Date{true, 13, 06, 94},
 			Variation: -4.2,
-			Latitude:  MustParseGPS("5133.82 N"),
-			Longitude: MustParseGPS("00042.24 W"),
+			Latitude:  Latitude(MustParseGPS("5133.82 N")),
+			Longitude: Longitude(MustParseGPS("00042.24 W")),
 		},
 	},
 	{
 		name: "good sentence B",
 		raw:  "$GNRMC,142754.0,A,4302.539570,N,07920.379823,W,0.0,,070617,0.0,E,A*21",
 		msg: GNRMC{
-			Time:      Time{true, 14, 27, 54, 0},
+			Time:      Time{true, 14, 27, 54, 0, 0},
 			Validity:  "A",
 			Speed:     0,
 			Course:    0,
 			Date:      Date{true, 7, 6, 17},
 			Variation: 0,
-			Latitude:  MustParseGPS("4302.539570 N"),
-			Longitude: MustParseGPS("07920.379823 W"),
+			Latitude:  Latitude(MustParseGPS("4302.539570 N")),
+			Longitude: Longitude(MustParseGPS("07920.379823 W")),
 		},
 	},
 	{
 		name: "good sentence C",
 		raw:  "$GNRMC,100538.00,A,5546.27711,N,03736.91144,E,0.061,,260318,,,A*60",
 		msg: GNRMC{
-			Time:      Time{true, 10, 5, 38, 0},
+			Time:      Time{true, 10, 5, 38, 0, 0},
 			Validity:  "A",
 			Speed:     0.061,
 			Course:    0,
 			Date:      Date{true, 26, 3, 18},
 			Variation: 0,
-			Latitude:  MustParseGPS("5546.27711 N"),
-			Longitude: MustParseGPS("03736.91144 E"),
+			Latitude:  Latitude(MustParseGPS("5546.27711 N")),
+			Longitude: Longitude(MustParseGPS("03736.91144 E")),
 		},
 	},
 	{
@@ -317,9 +317,9 @@ var gpggatests = []struct {
 		name: "good sentence",
 		raw:  "$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*51",
 		msg: GPGGA{
-			Time:          Time{true, 3, 42, 25, 77},
-			Latitude:      MustParseLatLong("3356.4650 S"),
-			Longitude:     MustParseLatLong("15124.5567 E"),
+			Time:          Time{true, 3, 42, 25, 77, 0},
+			Latitude:      Latitude(MustParseLatLong("3356.4650 S")),
+			Longitude:     Longitude(MustParseLatLong("15124.5567 E")),
 			FixQuality:    GPS,
 			NumSatellites: 03,
 			HDOP:          9.7,
@@ -373,8 +373,8 @@ var gpglltests = []struct {
 		name: "good sentence",
 		raw:  "$GPGLL,3926.7952,N,12000.5947,W,022732,A,A*58",
 		msg: GPGLL{
-			Latitude:  MustParseLatLong("3926.7952 N"),
-			Longitude: MustParseLatLong("12000.5947 W"),
+			Latitude:  Latitude(MustParseLatLong("3926.7952 N")),
+			Longitude: Longitude(MustParseLatLong("12000.5947 W")),
 			Time: Time{
 				Valid:       true,
 				Hour:        2,
@@ -598,28 +598,28 @@ var gprmctests = []struct {
 		name: "good sentence A",
 		raw:  "$GPRMC,220516,A,5133.82,N,00042.24,W,173.8,231.8,130694,004.2,W*70",
 		msg: GPRMC{
-			Time:      Time{true, 22, 5, 16, 0},
+			Time:      Time{true, 22, 5, 16, 0, 0},
 			Validity:  "A",
 			Speed:     173.8,
 			Course:    231.8,
 			Date:      Date{true, 13, 6, 94},
 			Variation: -4.2,
-			Latitude:  MustParseGPS("5133.82 N"),
-			Longitude: MustParseGPS("00042.24 W"),
+			Latitude:  Latitude(MustParseGPS("5133.82 N")),
+			Longitude: Longitude(MustParseGPS("00042.24 W")),
 		},
 	},
 	{
 		name: "good sentence B",
 		raw:  "$GPRMC,142754.0,A,4302.539570,N,07920.379823,W,0.0,,070617,0.0,E,A*3F",
 		msg: GPRMC{
-			Time:      Time{true, 14, 27, 54, 0},
+			Time:      Time{true, 14, 27, 54, 0, 0},
 			Validity:  "A",
 			Speed:     0,
 			Course:    0,
 			Date:      Date{true, 7, 6, 17},
 			Variation: 0,
-			Latitude:  MustParseGPS("4302.539570 N"),
-			Longitude: MustParseGPS("07920.379823 W"),
+			Latitude:  Latitude(MustParseGPS("4302.539570 N")),
+			Longitude: Longitude(MustParseGPS("07920.379823 W")),
 		},
 	},
 	{
@@ -712,9 +712,14 @@ var gpzdatests = []struct {
 	},
 	{
 		name: "invalid day",
-		raw:  "$GPZDA,220516,D,5133.82,N,00042.24,W,173.8,231.8,130694,004.2,W*76",
+		raw:  "$GPZDA,172809.456,D,07,1996,00,00*10",
 		err:  "nmea: GPZDA invalid day: D",
 	},
+	{
+		name: "invalid number of fields",
+		raw:  "$GPZDA,220516,D,5133.82,N,00042.24,W,173.8,231.8,130694,004.2,W*76",
+		err:  "nmea: GPZDA invalid number of fields: 11",
+	},
 }
 
 func TestGPZDA(t *testing.T) {
@@ -0,0 +1,69 @@
+package nmea
+
+const (
+	// TypeDOR type for DOR sentences
+	TypeDOR = "DOR"
+	// SectionDOR message type character, status for a section of doors
+	SectionDOR = "S"
+	// SingleDOR message type character, status for a single door
+	SingleDOR = "E"
+	// FaultDOR message type character, fault in the system
+	FaultDOR = "F"
+	// WatertightDOR door system type, watertight doors
+	WatertightDOR = "WT"
+	// SemiWatertightDOR door system type, semi-watertight doors
+	SemiWatertightDOR = "WS"
+	// FireDOR door system type, fire doors
+	FireDOR = "FD"
+	// HullDOR door system type, hull (shell) doors
+	HullDOR = "HD"
+	// OtherDOR door system type, other doors
+	OtherDOR = "OT"
+	// OpenDOR door status character
+	OpenDOR = "O"
+	// ClosedDOR door status character
+	ClosedDOR = "C"
+	// SecuredDOR door status character
+	SecuredDOR = "S"
+	// FreeDOR door status character, free status for watertight doors
+	FreeDOR = "F"
+	// FaultStatusDOR door status character, fault
+	FaultStatusDOR = "X"
+	// HarbourModeDOR watertight door switch setting character, harbour mode (allowed open)
+	HarbourModeDOR = "O"
+	// SeaModeDOR watertight door switch setting character, sea mode (ordered closed)
+	SeaModeDOR = "C"
+)
+
+// DOR is the door status detection sentence sent by hull opening monitoring systems.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_dor_door_status_detection
+type DOR struct {
+	BaseSentence
+	MessageType             string // Message type - S-section, E-single door, F-fault
+	Time                    Time   // Time of the event
+	SystemType              string // Type of door monitoring system - WT, WS, FD, HD, OT
+	FirstDivisionIndicator  string // First division indicator, e.g. fire zone
+	SecondDivisionIndicator string // Second division indicator, e.g. deck
+	DoorNumber              int64  // Door number or number of doors open
+	Status                  string // Door status - O-open, C-closed, S-secured, F-free, X-fault
+	SwitchSetting           string // Watertight door switch setting - O-harbour mode, C-sea mode
+	Description             string // Message description text
+}
+
+// newDOR constructor
+func newDOR(s BaseSentence) (DOR, error) {
+	p := newParser(s)
+	p.AssertType(TypeDOR)
+	return DOR{
+		BaseSentence:            s,
+		MessageType:             p.EnumString(0, "message type", SectionDOR, SingleDOR, FaultDOR),
+		Time:                    p.Time(1, "time"),
+		SystemType:              p.EnumString(2, "system type", WatertightDOR, SemiWatertightDOR, FireDOR, HullDOR, OtherDOR),
+		FirstDivisionIndicator:  p.String(3, "first division indicator"),
+		SecondDivisionIndicator: p.String(4, "second division indicator"),
+		DoorNumber:              p.Int64(5, "door number"),
+		Status:                  p.EnumString(6, "door status", OpenDOR, ClosedDOR, SecuredDOR, FreeDOR, FaultStatusDOR),
+		SwitchSetting:           p.EnumString(7, "switch setting", HarbourModeDOR, SeaModeDOR),
+		Description:             p.Text(8, "description"),
+	}, p.Err()
+}
@@ -0,0 +1,57 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var dortests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  DOR
+}{
+	{
+		name: "good sentence",
+		raw:  "$FRDOR,E,233042.00,FD,FP,000,010,C,C,Door Closed : TEST FPA Name*63",
+		msg: DOR{
+			MessageType:             SingleDOR,
+			Time:                    Time{true, 23, 30, 42, 0, 0},
+			SystemType:              FireDOR,
+			FirstDivisionIndicator:  "FP",
+			SecondDivisionIndicator: "000",
+			DoorNumber:              10,
+			Status:                  ClosedDOR,
+			SwitchSetting:           SeaModeDOR,
+			Description:             "Door Closed : TEST FPA Name",
+		},
+	},
+	{
+		name: "invalid door status",
+		raw:  "$FRDOR,E,233042.00,FD,FP,000,010,Q,C,Door Closed : TEST FPA Name*71",
+		err:  "nmea: FRDOR invalid door status: Q",
+	},
+	{
+		name: "invalid system type",
+		raw:  "$FRDOR,E,233042.00,XX,FP,000,010,C,C,Door Closed : TEST FPA Name*61",
+		err:  "nmea: FRDOR invalid system type: XX",
+	},
+}
+
+func TestDOR(t *testing.T) {
+	for _, tt := range dortests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				dor := m.(DOR)
+				dor.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, dor)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package nmea
+
+const (
+	// TypeDSC type for DSC sentences
+	TypeDSC = "DSC"
+	// AcknowledgementRequestDSC acknowledgement character, acknowledge request
+	AcknowledgementRequestDSC = "R"
+	// AcknowledgementDSC acknowledgement character, reply to an acknowledge request
+	AcknowledgementDSC = "B"
+	// AcknowledgementNeitherDSC acknowledgement character, neither request nor reply
+	AcknowledgementNeitherDSC = "S"
+	// ExpansionDSC expansion indicator character, a DSE sentence follows
+	ExpansionDSC = "E"
+)
+
+// DSC is the digital selective calling information received by a VHF/DSC radio.
+// Codes are kept as strings since they are decimal symbols with significant leading zeros.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_dsc_digital_selective_calling_information
+type DSC struct {
+	BaseSentence
+	FormatSpecifier                 string // Format specifier (e.g. 12-distress, 20-individual)
+	Address                         string // MMSI of the calling station or geographic area
+	Category                        string // Category (e.g. 00-routine, 12-distress)
+	DistressCauseOrTelecommand1     string // Nature of distress or first telecommand
+	CommunicationTypeOrTelecommand2 string // Type of communication or second telecommand
+	PositionOrCanal                 string // Position (quadrant, latitude and longitude) or channel/frequency
+	TimeOrTelephoneNumber           string // UTC time (hhmm) or telephone number
+	MMSI                            string // MMSI of the ship in distress
+	DistressCause                   string // Nature of distress
+	Acknowledgement                 string // Acknowledgement - R-request, B-reply, S-neither
+	Expansion                       bool   // A DSE expansion sentence follows
+}
+
+// newDSC constructor
+func newDSC(s BaseSentence) (DSC, error) {
+	p := newParser(s)
+	p.AssertType(TypeDSC)
+	return DSC{
+		BaseSentence:                    s,
+		FormatSpecifier:                 p.String(0, "format specifier"),
+		Address:                         p.String(1, "address"),
+		Category:                        p.String(2, "category"),
+		DistressCauseOrTelecommand1:     p.String(3, "nature of distress or telecommand 1"),
+		CommunicationTypeOrTelecommand2: p.String(4, "type of communication or telecommand 2"),
+		PositionOrCanal:                 p.String(5, "position or canal"),
+		TimeOrTelephoneNumber:           p.String(6, "time or telephone number"),
+		MMSI:                            p.String(7, "MMSI"),
+		DistressCause:                   p.String(8, "nature of distress"),
+		Acknowledgement:                 p.EnumString(9, "acknowledgement", AcknowledgementRequestDSC, AcknowledgementDSC, AcknowledgementNeitherDSC),
+		Expansion:                       p.EnumString(10, "expansion indicator", ExpansionDSC) == ExpansionDSC,
+	}, p.Err()
+}
@@ -0,0 +1,71 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var dsctests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  DSC
+}{
+	{
+		name: "good sentence",
+		raw:  "$CDDSC,20,3380400790,00,21,26,1423108312,2021,,,B,E*73",
+		msg: DSC{
+			FormatSpecifier:                 "20",
+			Address:                         "3380400790",
+			Category:                        "00",
+			DistressCauseOrTelecommand1:     "21",
+			CommunicationTypeOrTelecommand2: "26",
+			PositionOrCanal:                 "1423108312",
+			TimeOrTelephoneNumber:           "2021",
+			Acknowledgement:                 AcknowledgementDSC,
+			Expansion:                       true,
+		},
+	},
+	{
+		name: "good sentence without expansion",
+		raw:  "$CDDSC,12,3380400790,12,06,00,1423108312,2019,,,S,*2F",
+		msg: DSC{
+			FormatSpecifier:                 "12",
+			Address:                         "3380400790",
+			Category:                        "12",
+			DistressCauseOrTelecommand1:     "06",
+			CommunicationTypeOrTelecommand2: "00",
+			PositionOrCanal:                 "1423108312",
+			TimeOrTelephoneNumber:           "2019",
+			Acknowledgement:                 AcknowledgementNeitherDSC,
+		},
+	},
+	{
+		name: "invalid acknowledgement",
+		raw:  "$CDDSC,20,3380400790,00,21,26,1423108312,2021,,,X,E*69",
+		err:  "nmea: CDDSC invalid acknowledgement: X",
+	},
+	{
+		name: "invalid expansion indicator",
+		raw:  "$CDDSC,20,3380400790,00,21,26,1423108312,2021,,,B,X*6E",
+		err:  "nmea: CDDSC invalid expansion indicator: X",
+	},
+}
+
+func TestDSC(t *testing.T) {
+	for _, tt := range dsctests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				dsc := m.(DSC)
+				dsc.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, dsc)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+const (
+	// TypeDSE type for DSE sentences
+	TypeDSE = "DSE"
+	// QueryDSE flag character, the sentence is a query
+	QueryDSE = "Q"
+	// ReplyDSE flag character, the sentence is a reply
+	ReplyDSE = "R"
+	// AutomaticDSE flag character, the sentence is sent automatically
+	AutomaticDSE = "A"
+	// EnhancedPositionDSE data set code for enhanced position resolution
+	EnhancedPositionDSE = "00"
+)
+
+// DSE is the expanded digital selective calling sentence, it follows a DSC sentence
+// which has the expansion indicator set.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_dse_extended_dsc
+type DSE struct {
+	BaseSentence
+	TotalNumber    int64        // Total number of sentences, 1 - 9
+	SentenceNumber int64        // Sentence number, 1 - 9
+	QueryFlag      string       // Query/reply flag - Q-query, R-reply, A-automatic
+	MMSI           string       // MMSI of the station
+	DataSets       []DSEDataSet // Code and data field pairs
+}
+
+// DSEDataSet is an expansion data set of a DSE sentence
+type DSEDataSet struct {
+	Code string // Data set code (e.g. 00-enhanced position resolution)
+	Data string // Data set data
+}
+
+// newDSE constructor
+func newDSE(s BaseSentence) (DSE, error) {
+	p := newParser(s)
+	p.AssertType(TypeDSE)
+	m := DSE{
+		BaseSentence:   s,
+		TotalNumber:    p.Int64(0, "total number of sentences"),
+		SentenceNumber: p.Int64(1, "sentence number"),
+		QueryFlag:      p.EnumString(2, "query/reply flag", QueryDSE, ReplyDSE, AutomaticDSE),
+		MMSI:           p.String(3, "MMSI"),
+	}
+	for i := 4; i < len(m.Fields); i += 2 {
+		m.DataSets = append(m.DataSets, DSEDataSet{
+			Code: p.String(i, "data set code"),
+			Data: p.String(i+1, "data set data"),
+		})
+	}
+	return m, p.Err()
+}
+
+// Expands reports whether the sentence carries the expansion data of the given DSC sentence.
+func (m DSE) Expands(d DSC) bool {
+	return d.Expansion && d.Address == m.MMSI
+}
+
+// DSEEnhancedPosition is the decoded enhanced position resolution data set (code 00).
+// It holds the fractions of the minutes that the DSC position leaves out.
+type DSEEnhancedPosition struct {
+	LatitudeMinutes  float64 // Fraction of the latitude minutes, 0 - 0.9999
+	LongitudeMinutes float64 // Fraction of the longitude minutes, 0 - 0.9999
+}
+
+// EnhancedPosition decodes the enhanced position resolution data set of the sentence.
+func (m DSE) EnhancedPosition() (DSEEnhancedPosition, error) {
+	for _, d := range m.DataSets {
+		if d.Code != EnhancedPositionDSE {
+			continue
+		}
+		v, err := strconv.ParseUint(d.Data, 10, 64)
+		if len(d.Data) != 8 || err != nil {
+			return DSEEnhancedPosition{}, fmt.Errorf("nmea: DSE invalid enhanced position: %s", d.Data)
+		}
+		return DSEEnhancedPosition{
+			LatitudeMinutes:  float64(v/10000) / 10000,
+			LongitudeMinutes: float64(v%10000) / 10000,
+		}, nil
+	}
+	return DSEEnhancedPosition{}, fmt.Errorf("nmea: DSE has no enhanced position data set")
+}
+
+// Position returns the position of the DSC sentence this sentence expands,
+// with the enhanced position resolution applied to its whole minutes.
+func (m DSE) Position(d DSC) (Position, error) {
+	if !m.Expands(d) {
+		return Position{}, fmt.Errorf("nmea: DSE %s does not expand DSC from %s", m.MMSI, d.Address)
+	}
+	e, err := m.EnhancedPosition()
+	if err != nil {
+		return Position{}, err
+	}
+	// The DSC position is the quadrant digit followed by ddmm latitude and dddmm longitude.
+	pos := d.PositionOrCanal
+	v, err := strconv.ParseUint(pos, 10, 64)
+	if len(pos) != 10 || err != nil || pos[0] > '3' {
+		return Position{}, fmt.Errorf("nmea: DSC invalid position: %s", pos)
+	}
+	latDegrees, latMinutes := v/1e7%100, v/1e5%100
+	lonDegrees, lonMinutes := v/100%1000, v%100
+	lat := float64(latDegrees) + (float64(latMinutes)+e.LatitudeMinutes)/60
+	lon := float64(lonDegrees) + (float64(lonMinutes)+e.LongitudeMinutes)/60
+	// Quadrants: 0-NE, 1-NW, 2-SE, 3-SW
+	if pos[0] >= '2' {
+		lat = -lat
+	}
+	if pos[0] == '1' || pos[0] == '3' {
+		lon = -lon
+	}
+	return Position{Latitude: Latitude(lat), Longitude: Longitude(lon)}, nil
+}
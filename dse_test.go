@@ -0,0 +1,112 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var dsetests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  DSE
+}{
+	{
+		name: "good sentence",
+		raw:  "$CDDSE,1,1,A,3380400790,00,46504347*15",
+		msg: DSE{
+			TotalNumber:    1,
+			SentenceNumber: 1,
+			QueryFlag:      AutomaticDSE,
+			MMSI:           "3380400790",
+			DataSets: []DSEDataSet{
+				{Code: EnhancedPositionDSE, Data: "46504347"},
+			},
+		},
+	},
+	{
+		name: "good sentence with multiple data sets",
+		raw:  "$CDDSE,1,1,A,3380400790,00,46504347,01,00*14",
+		msg: DSE{
+			TotalNumber:    1,
+			SentenceNumber: 1,
+			QueryFlag:      AutomaticDSE,
+			MMSI:           "3380400790",
+			DataSets: []DSEDataSet{
+				{Code: EnhancedPositionDSE, Data: "46504347"},
+				{Code: "01", Data: "00"},
+			},
+		},
+	},
+	{
+		name: "invalid query flag",
+		raw:  "$CDDSE,1,1,X,3380400790,00,46504347*0C",
+		err:  "nmea: CDDSE invalid query/reply flag: X",
+	},
+	{
+		name: "missing data set data",
+		raw:  "$CDDSE,1,1,A,3380400790,00*3A",
+		err:  "nmea: CDDSE invalid data set data: index out of range",
+	},
+}
+
+func TestDSE(t *testing.T) {
+	for _, tt := range dsetests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				dse := m.(DSE)
+				dse.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, dse)
+			}
+		})
+	}
+}
+
+func TestDSEExpands(t *testing.T) {
+	dsc, err := Parse("$CDDSC,20,3380400790,00,21,26,1423108312,2021,,,B,E*73")
+	assert.NoError(t, err)
+	dse, err := Parse("$CDDSE,1,1,A,3380400790,00,46504347*15")
+	assert.NoError(t, err)
+	assert.True(t, dse.(DSE).Expands(dsc.(DSC)))
+
+	other := dsc.(DSC)
+	other.Expansion = false
+	assert.False(t, dse.(DSE).Expands(other))
+}
+
+func TestDSEPosition(t *testing.T) {
+	dsc, err := Parse("$CDDSC,20,3380400790,00,21,26,1423108312,2021,,,B,E*73")
+	assert.NoError(t, err)
+	dse, err := Parse("$CDDSE,1,1,A,3380400790,00,46504347*15")
+	assert.NoError(t, err)
+
+	e, err := dse.(DSE).EnhancedPosition()
+	assert.NoError(t, err)
+	assert.Equal(t, DSEEnhancedPosition{LatitudeMinutes: 0.465, LongitudeMinutes: 0.4347}, e)
+
+	pos, err := dse.(DSE).Position(dsc.(DSC))
+	assert.NoError(t, err)
+	assert.InDelta(t, 42+31.4650/60, pos.Latitude.Decimal(), 1e-9)
+	assert.InDelta(t, -(83 + 12.4347/60), pos.Longitude.Decimal(), 1e-9)
+	assert.Equal(t, "4231.4650,N", pos.Latitude.NMEA())
+	assert.Equal(t, "08312.4347,W", pos.Longitude.NMEA())
+
+	other := dsc.(DSC)
+	other.PositionOrCanal = "9999999999"
+	_, err = dse.(DSE).Position(other)
+	assert.EqualError(t, err, "nmea: DSC invalid position: 9999999999")
+	other.Address = "1234567890"
+	_, err = dse.(DSE).Position(other)
+	assert.EqualError(t, err, "nmea: DSE 3380400790 does not expand DSC from 1234567890")
+
+	dse, err = Parse("$CDDSE,1,1,A,3380400790,01,00*17")
+	assert.NoError(t, err)
+	_, err = dse.(DSE).EnhancedPosition()
+	assert.EqualError(t, err, "nmea: DSE has no enhanced position data set")
+}
@@ -0,0 +1,55 @@
+package nmea
+
+const (
+	// TypeDTM type for DTM sentences
+	TypeDTM = "DTM"
+	// DatumWGS84 WGS84 datum code
+	DatumWGS84 = "W84"
+	// DatumWGS72 WGS72 datum code
+	DatumWGS72 = "W72"
+	// DatumSGS85 SGS85 datum code
+	DatumSGS85 = "S85"
+	// DatumPE90 PE90 datum code
+	DatumPE90 = "P90"
+	// DatumUserDefined user defined datum code
+	DatumUserDefined = "999"
+)
+
+// DTM is the datum reference, the local datum and its offsets from the reference datum.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_dtm_datum_reference
+type DTM struct {
+	BaseSentence
+	LocalDatumCode        string  // Local datum code (e.g. W84)
+	LocalDatumSubdivision string  // Local datum subdivision code
+	LatitudeOffset        float64 // Latitude offset in minutes, negative for south
+	LongitudeOffset       float64 // Longitude offset in minutes, negative for west
+	AltitudeOffset        float64 // Altitude offset in meters
+	ReferenceDatumCode    string  // Reference datum code (e.g. W84)
+}
+
+// dtmLayout is the field layout of DTM sentences.
+var dtmLayout = newLayout(DTM{}, TypeDTM, []int{8},
+	layoutField{name: "LocalDatumCode", context: "local datum code", kind: stringField},
+	layoutField{name: "LocalDatumSubdivision", context: "local datum subdivision", kind: stringField},
+	layoutField{name: "LatitudeOffset", context: "latitude offset", kind: signedField, options: []string{North, South}, direction: "latitude offset direction"},
+	layoutField{name: "LongitudeOffset", context: "longitude offset", kind: signedField, options: []string{East, West}, direction: "longitude offset direction"},
+	layoutField{name: "AltitudeOffset", context: "altitude offset", kind: floatField},
+	layoutField{name: "ReferenceDatumCode", context: "reference datum code", kind: stringField},
+)
+
+// newDTM constructor
+func newDTM(s BaseSentence) (DTM, error) {
+	var m DTM
+	err := dtmLayout.parse(s, &m)
+	return m, err
+}
+
+// Encode encodes m into a DTM sentence using the talker of m.
+func (m DTM) Encode() string {
+	return dtmLayout.encode(m.Talker, m)
+}
+
+// IsWGS84 reports whether positions are given in the WGS84 datum.
+func (m DTM) IsWGS84() bool {
+	return m.LocalDatumCode == DatumWGS84 && m.ReferenceDatumCode == DatumWGS84
+}
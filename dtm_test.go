@@ -0,0 +1,83 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var dtmtests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  DTM
+}{
+	{
+		name: "good sentence",
+		raw:  "$GPDTM,W84,,0.0,N,0.0,E,0.0,W84*6F",
+		msg: DTM{
+			LocalDatumCode:     DatumWGS84,
+			ReferenceDatumCode: DatumWGS84,
+		},
+	},
+	{
+		name: "good sentence with offsets",
+		raw:  "$GPDTM,999,CH,0.08,N,0.07,E,-47.7,W84*10",
+		msg: DTM{
+			LocalDatumCode:        DatumUserDefined,
+			LocalDatumSubdivision: "CH",
+			LatitudeOffset:        0.08,
+			LongitudeOffset:       0.07,
+			AltitudeOffset:        -47.7,
+			ReferenceDatumCode:    DatumWGS84,
+		},
+	},
+	{
+		name: "good sentence with south and west offsets",
+		raw:  "$GPDTM,999,,0.08,S,0.07,W,-47.7,W84*14",
+		msg: DTM{
+			LocalDatumCode:     DatumUserDefined,
+			LatitudeOffset:     -0.08,
+			LongitudeOffset:    -0.07,
+			AltitudeOffset:     -47.7,
+			ReferenceDatumCode: DatumWGS84,
+		},
+	},
+	{
+		name: "invalid latitude offset direction",
+		raw:  "$GPDTM,999,,0.08,X,0.07,E,-47.7,W84*0D",
+		err:  "nmea: GPDTM invalid latitude offset direction: X",
+	},
+	{
+		name: "invalid longitude offset direction",
+		raw:  "$GPDTM,999,,0.08,N,0.07,X,-47.7,W84*06",
+		err:  "nmea: GPDTM invalid longitude offset direction: X",
+	},
+	{
+		name: "invalid altitude offset",
+		raw:  "$GPDTM,999,,0.08,N,0.07,E,x47.7,W84*4E",
+		err:  "nmea: GPDTM invalid altitude offset: x47.7",
+	},
+}
+
+func TestDTM(t *testing.T) {
+	for _, tt := range dtmtests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				dtm := m.(DTM)
+				dtm.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, dtm)
+			}
+		})
+	}
+}
+
+func TestDTMIsWGS84(t *testing.T) {
+	assert.True(t, DTM{LocalDatumCode: DatumWGS84, ReferenceDatumCode: DatumWGS84}.IsWGS84())
+	assert.False(t, DTM{LocalDatumCode: DatumUserDefined, ReferenceDatumCode: DatumWGS84}.IsWGS84())
+}
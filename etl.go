@@ -0,0 +1,49 @@
+package nmea
+
+const (
+	// TypeETL type for ETL sentences
+	TypeETL = "ETL"
+	// OrderETL message type character, the sentence is an order
+	OrderETL = "O"
+	// AnswerBackETL message type character, the sentence is an answer-back
+	AnswerBackETL = "A"
+	// BridgeETL operating location character, bridge
+	BridgeETL = "B"
+	// PortWingETL operating location character, port wing
+	PortWingETL = "P"
+	// StarboardWingETL operating location character, starboard wing
+	StarboardWingETL = "S"
+	// EngineControlRoomETL operating location character, engine control room
+	EngineControlRoomETL = "C"
+	// EngineSideETL operating location character, engine side or local
+	EngineSideETL = "E"
+	// WingETL operating location character, wing, port or starboard not specified
+	WingETL = "W"
+)
+
+// ETL is the engine telegraph operation status.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_etl_engine_telegraph_operation_status
+type ETL struct {
+	BaseSentence
+	Time                 Time   // Event time
+	MessageType          string // Message type - O-order, A-answer-back
+	Position             string // Position indicator of the engine telegraph, e.g. 00 stop engine, 12 half ahead
+	SubTelegraphPosition string // Position indicator of the sub-telegraph, e.g. 20 S/B, 30 F/A, 40 F/E
+	OperatingLocation    string // Operating location - B-bridge, P-port wing, S-starboard wing, C-engine control room, E-engine side, W-wing
+	EngineNumber         int64  // Number of the engine or propeller shaft, 0 - 9
+}
+
+// newETL constructor
+func newETL(s BaseSentence) (ETL, error) {
+	p := newParser(s)
+	p.AssertType(TypeETL)
+	return ETL{
+		BaseSentence:         s,
+		Time:                 p.Time(0, "time"),
+		MessageType:          p.EnumString(1, "message type", OrderETL, AnswerBackETL),
+		Position:             p.String(2, "engine telegraph position"),
+		SubTelegraphPosition: p.String(3, "sub-telegraph position"),
+		OperatingLocation:    p.EnumString(4, "operating location", BridgeETL, PortWingETL, StarboardWingETL, EngineControlRoomETL, EngineSideETL, WingETL),
+		EngineNumber:         p.Int64(5, "engine number"),
+	}, p.Err()
+}
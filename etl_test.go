@@ -0,0 +1,54 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var etltests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  ETL
+}{
+	{
+		name: "good sentence",
+		raw:  "$ERETL,001122.00,A,12,20,B,1*57",
+		msg: ETL{
+			Time:                 Time{true, 0, 11, 22, 0, 0},
+			MessageType:          AnswerBackETL,
+			Position:             "12",
+			SubTelegraphPosition: "20",
+			OperatingLocation:    BridgeETL,
+			EngineNumber:         1,
+		},
+	},
+	{
+		name: "invalid operating location",
+		raw:  "$ERETL,001122.00,O,00,30,X,1*41",
+		err:  "nmea: ERETL invalid operating location: X",
+	},
+	{
+		name: "invalid message type",
+		raw:  "$ERETL,001122.00,Q,12,20,B,1*47",
+		err:  "nmea: ERETL invalid message type: Q",
+	},
+}
+
+func TestETL(t *testing.T) {
+	for _, tt := range etltests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				etl := m.(ETL)
+				etl.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, etl)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package nmea
+
+const (
+	// TypeEVE type for EVE sentences
+	TypeEVE = "EVE"
+)
+
+// EVE is a general event message, used by voyage data recorders.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_eve_general_event_message
+type EVE struct {
+	BaseSentence
+	Time        Time   // Event UTC time
+	TagCode     string // Tag code, used for identification of the source of the event
+	Description string // Event description text
+}
+
+// newEVE constructor
+func newEVE(s BaseSentence) (EVE, error) {
+	p := newParser(s)
+	p.AssertType(TypeEVE)
+	return EVE{
+		BaseSentence: s,
+		Time:         p.Time(0, "time"),
+		TagCode:      p.String(1, "tag code"),
+		Description:  p.Text(2, "description"),
+	}, p.Err()
+}
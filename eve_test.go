@@ -0,0 +1,46 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var evetests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  EVE
+}{
+	{
+		name: "good sentence",
+		raw:  "$FMEVE,000001,DZ00513,Fire Alarm On*13",
+		msg: EVE{
+			Time:        Time{true, 0, 0, 1, 0, 0},
+			TagCode:     "DZ00513",
+			Description: "Fire Alarm On",
+		},
+	},
+	{
+		name: "invalid time",
+		raw:  "$FMEVE,0000x1,DZ00513,Fire Alarm On*5B",
+		err:  "nmea: FMEVE invalid time: 0000x1",
+	},
+}
+
+func TestEVE(t *testing.T) {
+	for _, tt := range evetests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				eve := m.(EVE)
+				eve.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, eve)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package nmea
+
+const (
+	// TypeFSI type for FSI sentences
+	TypeFSI = "FSI"
+	// StatusReportFSI sentence status flag character, the sentence is a status report
+	StatusReportFSI = "R"
+	// StatusConfigurationFSI sentence status flag character, the sentence is a configuration command
+	StatusConfigurationFSI = "C"
+)
+
+// FSI is the frequency set information of a radiotelephone.
+// Frequencies are kept as strings since they are either in 100 Hz units or an ITU channel number.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_fsi_frequency_set_information
+type FSI struct {
+	BaseSentence
+	TransmittingFrequency string // Transmitting frequency
+	ReceivingFrequency    string // Receiving frequency
+	CommunicationsMode    string // Mode of operation (e.g. m-J3E telephone)
+	PowerLevel            int64  // Power level, 0 - standby, 1 - lowest, 9 - highest
+	SentenceStatus        string // Sentence status flag - R-status report, C-configuration command (optional)
+}
+
+// newFSI constructor
+func newFSI(s BaseSentence) (FSI, error) {
+	p := newParser(s)
+	p.AssertType(TypeFSI)
+	p.AssertFieldCount(4, 5)
+	m := FSI{
+		BaseSentence:          s,
+		TransmittingFrequency: p.String(0, "transmitting frequency"),
+		ReceivingFrequency:    p.String(1, "receiving frequency"),
+		CommunicationsMode:    p.String(2, "communications mode"),
+		PowerLevel:            p.Int64(3, "power level"),
+	}
+	if len(m.Fields) > 4 {
+		m.SentenceStatus = p.EnumString(4, "sentence status", StatusReportFSI, StatusConfigurationFSI)
+	}
+	return m, p.Err()
+}
@@ -0,0 +1,63 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var fsitests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  FSI
+}{
+	{
+		name: "good sentence",
+		raw:  "$RAFSI,020230,026140,m,0*10",
+		msg: FSI{
+			TransmittingFrequency: "020230",
+			ReceivingFrequency:    "026140",
+			CommunicationsMode:    "m",
+			PowerLevel:            0,
+		},
+	},
+	{
+		name: "good sentence with sentence status",
+		raw:  "$RAFSI,020230,026140,m,0,R*6E",
+		msg: FSI{
+			TransmittingFrequency: "020230",
+			ReceivingFrequency:    "026140",
+			CommunicationsMode:    "m",
+			PowerLevel:            0,
+			SentenceStatus:        StatusReportFSI,
+		},
+	},
+	{
+		name: "invalid power level",
+		raw:  "$RAFSI,020230,026140,m,x*58",
+		err:  "nmea: RAFSI invalid power level: x",
+	},
+	{
+		name: "invalid sentence status",
+		raw:  "$RAFSI,020230,026140,m,0,X*64",
+		err:  "nmea: RAFSI invalid sentence status: X",
+	},
+}
+
+func TestFSI(t *testing.T) {
+	for _, tt := range fsitests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				fsi := m.(FSI)
+				fsi.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, fsi)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package nmea
+
+const (
+	// TypeGBS type for GBS sentences
+	TypeGBS = "GBS"
+)
+
+// GBS is the GNSS satellite fault detection, used for RAIM (receiver autonomous integrity monitoring).
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_gbs_gps_satellite_fault_detection
+type GBS struct {
+	BaseSentence
+	Time              Time    // UTC time of the associated fix
+	LatitudeError     float64 // Expected error in latitude in meters
+	LongitudeError    float64 // Expected error in longitude in meters
+	AltitudeError     float64 // Expected error in altitude in meters
+	FailedSatelliteID int64   // ID of the most likely failed satellite
+	MissProbability   float64 // Probability of missed detection of the most likely failed satellite
+	Bias              float64 // Estimated bias of the most likely failed satellite in meters
+	StdDeviation      float64 // Standard deviation of the bias estimate
+	SystemID          int64   // GNSS system ID (NMEA 4.1 and later)
+	SignalID          int64   // GNSS signal ID (NMEA 4.1 and later)
+}
+
+// newGBS constructor
+func newGBS(s BaseSentence) (GBS, error) {
+	p := newParser(s)
+	p.AssertType(TypeGBS)
+	p.AssertFieldCount(8, 10)
+	m := GBS{
+		BaseSentence:      s,
+		Time:              p.Time(0, "time"),
+		LatitudeError:     p.Float64(1, "latitude error"),
+		LongitudeError:    p.Float64(2, "longitude error"),
+		AltitudeError:     p.Float64(3, "altitude error"),
+		FailedSatelliteID: p.Int64(4, "failed satellite ID"),
+		MissProbability:   p.Float64(5, "miss probability"),
+		Bias:              p.Float64(6, "bias"),
+		StdDeviation:      p.Float64(7, "standard deviation"),
+	}
+	if len(m.Fields) > 8 {
+		m.SystemID = p.Int64(8, "system ID")
+		m.SignalID = p.Int64(9, "signal ID")
+	}
+	return m, p.Err()
+}
@@ -0,0 +1,66 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var gbstests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  GBS
+}{
+	{
+		name: "good sentence",
+		raw:  "$GPGBS,015509.00,-0.031,-0.186,0.219,19,0.000,-0.354,6.972*4D",
+		msg: GBS{
+			Time:              Time{true, 1, 55, 9, 0, 0},
+			LatitudeError:     -0.031,
+			LongitudeError:    -0.186,
+			AltitudeError:     0.219,
+			FailedSatelliteID: 19,
+			MissProbability:   0,
+			Bias:              -0.354,
+			StdDeviation:      6.972,
+		},
+	},
+	{
+		name: "good sentence with system and signal ID",
+		raw:  "$GNGBS,235458.00,1.4,1.3,3.1,03,,-21.4,3.8,1,0*44",
+		msg: GBS{
+			Time:              Time{true, 23, 54, 58, 0, 0},
+			LatitudeError:     1.4,
+			LongitudeError:    1.3,
+			AltitudeError:     3.1,
+			FailedSatelliteID: 3,
+			Bias:              -21.4,
+			StdDeviation:      3.8,
+			SystemID:          1,
+			SignalID:          0,
+		},
+	},
+	{
+		name: "invalid failed satellite ID",
+		raw:  "$GPGBS,015509.00,-0.031,-0.186,0.219,x9,0.000,-0.354,6.972*04",
+		err:  "nmea: GPGBS invalid failed satellite ID: x9",
+	},
+}
+
+func TestGBS(t *testing.T) {
+	for _, tt := range gbstests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				gbs := m.(GBS)
+				gbs.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, gbs)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package nmea
+
+const (
+	// TypeGFA type for GFA sentences
+	TypeGFA = "GFA"
+	// SafeGFA integrity status Character
+	SafeGFA = "S"
+	// CautionGFA integrity status Character
+	CautionGFA = "C"
+	// UnsafeGFA integrity status Character
+	UnsafeGFA = "U"
+	// NotInUseGFA integrity status Character
+	NotInUseGFA = "V"
+)
+
+// GFA is the GNSS fix accuracy and integrity sentence.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_gfa_gnss_fix_accuracy_and_integrity
+type GFA struct {
+	BaseSentence
+	Time                  Time     // UTC time of the associated position fix
+	HorizontalProtection  float64  // Horizontal protection level (meters)
+	VerticalProtection    float64  // Vertical protection level (meters)
+	SemiMajorDeviation    float64  // Standard deviation of semi-major axis of error ellipse (meters)
+	SemiMinorDeviation    float64  // Standard deviation of semi-minor axis of error ellipse (meters)
+	SemiMajorOrientation  float64  // Orientation of semi-major axis of error ellipse (degrees from true north)
+	AltitudeDeviation     float64  // Standard deviation of altitude (meters)
+	SelectedAccuracyLevel float64  // Selected accuracy level (meters)
+	IntegrityStatus       []string // Integrity status per integrity source - RAIM, SBAS, Galileo
+}
+
+// newGFA constructor
+func newGFA(s BaseSentence) (GFA, error) {
+	p := newParser(s)
+	p.AssertType(TypeGFA)
+	return GFA{
+		BaseSentence:          s,
+		Time:                  p.Time(0, "time"),
+		HorizontalProtection:  p.Float64(1, "horizontal protection level"),
+		VerticalProtection:    p.Float64(2, "vertical protection level"),
+		SemiMajorDeviation:    p.Float64(3, "semi-major standard deviation"),
+		SemiMinorDeviation:    p.Float64(4, "semi-minor standard deviation"),
+		SemiMajorOrientation:  p.Float64(5, "semi-major orientation"),
+		AltitudeDeviation:     p.Float64(6, "altitude standard deviation"),
+		SelectedAccuracyLevel: p.Float64(7, "selected accuracy level"),
+		IntegrityStatus:       p.EnumChars(8, "integrity status", SafeGFA, CautionGFA, UnsafeGFA, NotInUseGFA),
+	}, p.Err()
+}
@@ -0,0 +1,57 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var gfatests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  GFA
+}{
+	{
+		name: "good sentence",
+		raw:  "$GPGFA,123519.00,15.0,22.5,3.2,2.1,45.0,4.8,10.0,SVV*2A",
+		msg: GFA{
+			Time:                  Time{true, 12, 35, 19, 0, 0},
+			HorizontalProtection:  15,
+			VerticalProtection:    22.5,
+			SemiMajorDeviation:    3.2,
+			SemiMinorDeviation:    2.1,
+			SemiMajorOrientation:  45,
+			AltitudeDeviation:     4.8,
+			SelectedAccuracyLevel: 10,
+			IntegrityStatus:       []string{SafeGFA, NotInUseGFA, NotInUseGFA},
+		},
+	},
+	{
+		name: "invalid integrity status",
+		raw:  "$GPGFA,123519.00,15.0,22.5,3.2,2.1,45.0,4.8,10.0,SXV*24",
+		err:  "nmea: GPGFA invalid integrity status: SXV",
+	},
+	{
+		name: "invalid horizontal protection level",
+		raw:  "$GPGFA,123519.00,x,22.5,3.2,2.1,45.0,4.8,10.0,SVV*48",
+		err:  "nmea: GPGFA invalid horizontal protection level: x",
+	},
+}
+
+func TestGFA(t *testing.T) {
+	for _, tt := range gfatests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				gfa := m.(GFA)
+				gfa.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, gfa)
+			}
+		})
+	}
+}
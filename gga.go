@@ -20,33 +20,47 @@ const (
 // GGA is the Time, position, and fix related data of the receiver.
 type GGA struct {
 	BaseSentence
-	Time          Time    // Time of fix.
-	Latitude      float64 // Latitude.
-	Longitude     float64 // Longitude.
-	FixQuality    string  // Quality of fix.
-	NumSatellites int64   // Number of satellites in use.
-	HDOP          float64 // Horizontal dilution of precision.
-	Altitude      float64 // Altitude.
-	Separation    float64 // Geoidal separation
-	DGPSAge       string  // Age of differential GPD data.
-	DGPSId        string  // DGPS reference station ID.
+	Time          Time      // Time of fix.
+	Latitude      Latitude  // Latitude.
+	Longitude     Longitude // Longitude.
+	FixQuality    string    // Quality of fix.
+	NumSatellites int64     // Number of satellites in use.
+	HDOP          float64   // Horizontal dilution of precision.
+	Altitude      float64   // Altitude.
+	Separation    float64   // Geoidal separation
+	DGPSAge       string    // Age of differential GPD data.
+	DGPSId        string    // DGPS reference station ID.
 }
 
+// ggaLayout is the field layout of GGA sentences.
+var ggaLayout = newLayout(GGA{}, TypeGGA, []int{14},
+	layoutField{name: "Time", context: "time", kind: timeField},
+	layoutField{name: "Latitude", context: "latitude", kind: latitudeField},
+	layoutField{name: "Longitude", context: "longitude", kind: longitudeField},
+	layoutField{name: "FixQuality", context: "fix quality", kind: enumField, options: []string{Invalid, GPS, DGPS, PPS, RTK, FRTK}},
+	layoutField{name: "NumSatellites", context: "number of satellites", kind: intField},
+	layoutField{name: "HDOP", context: "hdop", kind: floatField},
+	layoutField{name: "Altitude", context: "altitude", kind: floatField},
+	layoutField{kind: unitField, options: []string{"M"}},
+	layoutField{name: "Separation", context: "separation", kind: floatField},
+	layoutField{kind: unitField, options: []string{"M"}},
+	layoutField{name: "DGPSAge", context: "dgps age", kind: stringField},
+	layoutField{name: "DGPSId", context: "dgps id", kind: stringField},
+)
+
 // newGGA constructor
 func newGGA(s BaseSentence) (GGA, error) {
-	p := newParser(s)
-	p.AssertType(TypeGGA)
-	return GGA{
-		BaseSentence:  s,
-		Time:          p.Time(0, "time"),
-		Latitude:      p.LatLong(1, 2, "latitude"),
-		Longitude:     p.LatLong(3, 4, "longitude"),
-		FixQuality:    p.EnumString(5, "fix quality", Invalid, GPS, DGPS, PPS, RTK, FRTK),
-		NumSatellites: p.Int64(6, "number of satellites"),
-		HDOP:          p.Float64(7, "hdop"),
-		Altitude:      p.Float64(8, "altitude"),
-		Separation:    p.Float64(10, "separation"),
-		DGPSAge:       p.String(12, "dgps age"),
-		DGPSId:        p.String(13, "dgps id"),
-	}, p.Err()
+	var m GGA
+	err := ggaLayout.parse(s, &m)
+	return m, err
+}
+
+// Encode encodes m into a GGA sentence using the talker of m.
+func (m GGA) Encode() string {
+	return ggaLayout.encode(m.Talker, m)
+}
+
+// Position returns the reported position.
+func (m GGA) Position() Position {
+	return Position{Latitude: m.Latitude, Longitude: m.Longitude}
 }
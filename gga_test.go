@@ -23,8 +23,8 @@ var ggatests = []struct {
 				Second:      15,
 				Millisecond: 0,
 			},
-			Latitude:      MustParseLatLong("6325.6138 N"),
-			Longitude:     MustParseLatLong("01021.4290 E"),
+			Latitude:      Latitude(MustParseLatLong("6325.6138 N")),
+			Longitude:     Longitude(MustParseLatLong("01021.4290 E")),
 			FixQuality:    "1",
 			NumSatellites: 8,
 			HDOP:          2.42,
@@ -53,9 +53,9 @@ var ggatests = []struct {
 		name: "good sentence",
 		raw:  "$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*51",
 		msg: GGA{
-			Time:          Time{true, 3, 42, 25, 77},
-			Latitude:      MustParseLatLong("3356.4650 S"),
-			Longitude:     MustParseLatLong("15124.5567 E"),
+			Time:          Time{true, 3, 42, 25, 77, 0},
+			Latitude:      Latitude(MustParseLatLong("3356.4650 S")),
+			Longitude:     Longitude(MustParseLatLong("15124.5567 E")),
 			FixQuality:    GPS,
 			NumSatellites: 03,
 			HDOP:          9.7,
@@ -0,0 +1,65 @@
+package nmea
+
+const (
+	// TypeGLC type for GLC sentences
+	TypeGLC = "GLC"
+	// ValidGLC signal status character
+	ValidGLC = "A"
+	// BlinkGLC signal status character, blink warning
+	BlinkGLC = "B"
+	// CycleGLC signal status character, cycle warning
+	CycleGLC = "C"
+	// SNRGLC signal status character, signal to noise ratio warning
+	SNRGLC = "S"
+)
+
+// GLC is the geographic position as Loran-C time differences.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_glc_geographic_position_loran_c
+type GLC struct {
+	BaseSentence
+	GRI         int64               // Group repetition interval in tens of microseconds
+	Master      GLCTimeDifference   // Master time of arrival and signal status
+	Secondaries []GLCTimeDifference // Time differences and signal status of up to five secondaries, in sentence order up to the last one received
+}
+
+// GLCTimeDifference is a Loran-C time difference, or time of arrival, with its signal status.
+type GLCTimeDifference struct {
+	TimeDifference float64 // Time difference in microseconds
+	Status         string  // Signal status - A-valid, B-blink warning, C-cycle warning, S-SNR warning
+	Valid          bool    // The time difference or its status was received, false for an empty pair
+}
+
+// newGLC constructor
+func newGLC(s BaseSentence) (GLC, error) {
+	p := newParser(s)
+	p.AssertType(TypeGLC)
+	m := GLC{
+		BaseSentence: s,
+		GRI:          p.Int64(0, "GRI"),
+		Master: GLCTimeDifference{
+			TimeDifference: p.Float64(1, "master TOA"),
+			Status:         p.EnumString(2, "master status", ValidGLC, BlinkGLC, CycleGLC, SNRGLC),
+			Valid:          p.HasValue(1) || p.HasValue(2),
+		},
+	}
+	// Empty pairs are kept so that each secondary stays at its position,
+	// only the empty pairs after the last secondary are dropped.
+	last := 0
+	for i := 3; i+1 < len(m.Fields) && i < 13; i += 2 {
+		td := GLCTimeDifference{
+			TimeDifference: p.Float64(i, "time difference"),
+			Status:         p.EnumString(i+1, "time difference status", ValidGLC, BlinkGLC, CycleGLC, SNRGLC),
+			Valid:          p.HasValue(i) || p.HasValue(i+1),
+		}
+		m.Secondaries = append(m.Secondaries, td)
+		if td.Valid {
+			last = len(m.Secondaries)
+		}
+	}
+	if last == 0 {
+		m.Secondaries = nil
+	} else {
+		m.Secondaries = m.Secondaries[:last]
+	}
+	return m, p.Err()
+}
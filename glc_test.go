@@ -0,0 +1,75 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var glctests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  GLC
+}{
+	{
+		name: "good sentence",
+		raw:  "$LCGLC,9960,14162.8,A,27479.7,A,41428.9,B,,,,,,*03",
+		msg: GLC{
+			GRI:    9960,
+			Master: GLCTimeDifference{14162.8, ValidGLC, true},
+			Secondaries: []GLCTimeDifference{
+				{27479.7, ValidGLC, true},
+				{41428.9, BlinkGLC, true},
+			},
+		},
+	},
+	{
+		name: "missing secondary",
+		raw:  "$LCGLC,9960,14162.8,A,27479.7,A,,,41428.9,B,,,,*03",
+		msg: GLC{
+			GRI:    9960,
+			Master: GLCTimeDifference{14162.8, ValidGLC, true},
+			Secondaries: []GLCTimeDifference{
+				{27479.7, ValidGLC, true},
+				{0, "", false},
+				{41428.9, BlinkGLC, true},
+			},
+		},
+	},
+	{
+		name: "no secondaries",
+		raw:  "$LCGLC,9960,14162.8,A,,,,,,,,,,*0A",
+		msg: GLC{
+			GRI:    9960,
+			Master: GLCTimeDifference{14162.8, ValidGLC, true},
+		},
+	},
+	{
+		name: "invalid time difference status",
+		raw:  "$LCGLC,9960,14162.8,A,27479.7,X,,,,,,,,*74",
+		err:  "nmea: LCGLC invalid time difference status: X",
+	},
+	{
+		name: "invalid GRI",
+		raw:  "$LCGLC,x960,14162.8,A,27479.7,A,,,,,,,,*2C",
+		err:  "nmea: LCGLC invalid GRI: x960",
+	},
+}
+
+func TestGLC(t *testing.T) {
+	for _, tt := range glctests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				glc := m.(GLC)
+				glc.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, glc)
+			}
+		})
+	}
+}
@@ -13,21 +13,33 @@ const (
 // http://aprs.gids.nl/nmea/#gll
 type GLL struct {
 	BaseSentence
-	Latitude  float64 // Latitude
-	Longitude float64 // Longitude
-	Time      Time    // Time Stamp
-	Validity  string  // validity - A-valid
+	Latitude  Latitude  // Latitude
+	Longitude Longitude // Longitude
+	Time      Time      // Time Stamp
+	Validity  string    // validity - A-valid
 }
 
+// gllLayout is the field layout of GLL sentences.
+var gllLayout = newLayout(GLL{}, TypeGLL, []int{6, 7},
+	layoutField{name: "Latitude", context: "latitude", kind: latitudeField},
+	layoutField{name: "Longitude", context: "longitude", kind: longitudeField},
+	layoutField{name: "Time", context: "time", kind: timeField},
+	layoutField{name: "Validity", context: "validity", kind: enumField, options: []string{ValidGLL, InvalidGLL}},
+)
+
 // newGLL constructor
 func newGLL(s BaseSentence) (GLL, error) {
-	p := newParser(s)
-	p.AssertType(TypeGLL)
-	return GLL{
-		BaseSentence: s,
-		Latitude:     p.LatLong(0, 1, "latitude"),
-		Longitude:    p.LatLong(2, 3, "longitude"),
-		Time:         p.Time(4, "time"),
-		Validity:     p.EnumString(5, "validity", ValidGLL, InvalidGLL),
-	}, p.Err()
+	var m GLL
+	err := gllLayout.parse(s, &m)
+	return m, err
+}
+
+// Encode encodes m into a GLL sentence using the talker of m.
+func (m GLL) Encode() string {
+	return gllLayout.encode(m.Talker, m)
+}
+
+// Position returns the reported position.
+func (m GLL) Position() Position {
+	return Position{Latitude: m.Latitude, Longitude: m.Longitude}
 }
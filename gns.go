@@ -21,14 +21,22 @@ const (
 	ManualGNS = "M"
 	// SimulatorGNS Character
 	SimulatorGNS = "S"
+	// SafeGNS navigational status Character
+	SafeGNS = "S"
+	// CautionGNS navigational status Character
+	CautionGNS = "C"
+	// UnsafeGNS navigational status Character
+	UnsafeGNS = "U"
+	// NotValidGNS navigational status Character
+	NotValidGNS = "V"
 )
 
 // GNS is standard GNSS sentance that combined multiple constellations
 type GNS struct {
 	BaseSentence
 	Time       Time
-	Latitude   float64
-	Longitude  float64
+	Latitude   Latitude
+	Longitude  Longitude
 	Mode       []string
 	SVs        int64
 	HDOP       float64
@@ -36,17 +44,19 @@ type GNS struct {
 	Separation float64
 	Age        float64
 	Station    int64
+	NavStatus  string // Navigational status (NMEA 4.1 and later)
 }
 
 // newGNS Constructor
 func newGNS(s BaseSentence) (GNS, error) {
 	p := newParser(s)
 	p.AssertType(TypeGNS)
+	p.AssertFieldCount(12, 13)
 	m := GNS{
 		BaseSentence: s,
 		Time:         p.Time(0, "time"),
-		Latitude:     p.LatLong(1, 2, "latitude"),
-		Longitude:    p.LatLong(3, 4, "longitude"),
+		Latitude:     p.Latitude(1, 2, "latitude"),
+		Longitude:    p.Longitude(3, 4, "longitude"),
 		Mode:         p.EnumChars(5, "mode", NoFixGNS, AutonomousGNS, DifferentialGNS, PreciseGNS, RealTimeKinematicGNS, FloatRTKGNS, EstimatedGNS, ManualGNS, SimulatorGNS),
 		SVs:          p.Int64(6, "SVs"),
 		HDOP:         p.Float64(7, "HDOP"),
@@ -55,5 +65,13 @@ func newGNS(s BaseSentence) (GNS, error) {
 		Age:          p.Float64(10, "age"),
 		Station:      p.Int64(11, "station"),
 	}
+	if len(m.Fields) > 12 {
+		m.NavStatus = p.EnumString(12, "navigational status", SafeGNS, CautionGNS, UnsafeGNS, NotValidGNS)
+	}
 	return m, p.Err()
 }
+
+// Position returns the reported position.
+func (m GNS) Position() Position {
+	return Position{Latitude: m.Latitude, Longitude: m.Longitude}
+}
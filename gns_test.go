@@ -16,9 +16,9 @@ var gnstests = []struct {
 		name: "good sentence A",
 		raw:  "$GNGNS,014035.00,4332.69262,S,17235.48549,E,RR,13,0.9,25.63,11.24,,*70",
 		msg: GNS{
-			Time:       Time{true, 1, 40, 35, 0},
-			Latitude:   MustParseGPS("4332.69262 S"),
-			Longitude:  MustParseGPS("17235.48549 E"),
+			Time:       Time{true, 1, 40, 35, 0, 0},
+			Latitude:   Latitude(MustParseGPS("4332.69262 S")),
+			Longitude:  Longitude(MustParseGPS("17235.48549 E")),
 			Mode:       []string{"R", "R"},
 			SVs:        13,
 			HDOP:       0.9,
@@ -32,9 +32,9 @@ var gnstests = []struct {
 		name: "good sentence B",
 		raw:  "$GNGNS,094821.0,4849.931307,N,00216.053323,E,AA,14,0.6,161.5,48.0,,*6D",
 		msg: GNS{
-			Time:       Time{true, 9, 48, 21, 0},
-			Latitude:   MustParseGPS("4849.931307 N"),
-			Longitude:  MustParseGPS("00216.053323 E"),
+			Time:       Time{true, 9, 48, 21, 0, 0},
+			Latitude:   Latitude(MustParseGPS("4849.931307 N")),
+			Longitude:  Longitude(MustParseGPS("00216.053323 E")),
 			Mode:       []string{"A", "A"},
 			SVs:        14,
 			HDOP:       0.6,
@@ -48,9 +48,9 @@ var gnstests = []struct {
 		name: "good sentence B",
 		raw:  "$GNGNS,094821.0,4849.931307,N,00216.053323,E,AAN,14,0.6,161.5,48.0,,*23",
 		msg: GNS{
-			Time:       Time{true, 9, 48, 21, 0},
-			Latitude:   MustParseGPS("4849.931307 N"),
-			Longitude:  MustParseGPS("00216.053323 E"),
+			Time:       Time{true, 9, 48, 21, 0, 0},
+			Latitude:   Latitude(MustParseGPS("4849.931307 N")),
+			Longitude:  Longitude(MustParseGPS("00216.053323 E")),
 			Mode:       []string{"A", "A", "N"},
 			SVs:        14,
 			HDOP:       0.6,
@@ -60,6 +60,28 @@ var gnstests = []struct {
 			Station:    0,
 		},
 	},
+	{
+		name: "good sentence with navigational status",
+		raw:  "$GNGNS,224749.00,3333.4268304,N,11153.3538273,W,D,19,0.6,406.110,-26.294,6.0,0138,S*74",
+		msg: GNS{
+			Time:       Time{true, 22, 47, 49, 0, 0},
+			Latitude:   Latitude(MustParseGPS("3333.4268304 N")),
+			Longitude:  Longitude(MustParseGPS("11153.3538273 W")),
+			Mode:       []string{"D"},
+			SVs:        19,
+			HDOP:       0.6,
+			Altitude:   406.110,
+			Separation: -26.294,
+			Age:        6.0,
+			Station:    138,
+			NavStatus:  SafeGNS,
+		},
+	},
+	{
+		name: "bad navigational status",
+		raw:  "$GNGNS,224749.00,3333.4268304,N,11153.3538273,W,D,19,0.6,406.110,-26.294,6.0,0138,X*7F",
+		err:  "nmea: GNGNS invalid navigational status: X",
+	},
 	{
 		name: "bad sentence",
 		raw:  "$GNGNS,094821.0,4849.931307,N,00216.053323,E,AAX,14,0.6,161.5,48.0,,*35",
@@ -0,0 +1,41 @@
+package nmea
+
+const (
+	// TypeGRS type for GRS sentences
+	TypeGRS = "GRS"
+	// ResidualsUsedGRS residuals were used to calculate the position given in the matching GGA
+	ResidualsUsedGRS = "0"
+	// ResidualsRecomputedGRS residuals were recomputed after the GGA position was computed
+	ResidualsRecomputedGRS = "1"
+)
+
+// GRS is the GNSS range residuals for each satellite used in the navigation solution.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_grs_gps_range_residuals
+type GRS struct {
+	BaseSentence
+	Time      Time      // UTC time of the GGA or GNS fix associated with this sentence
+	Mode      string    // Residual mode
+	Residuals []float64 // Range residuals in meters for the 12 satellites, in GSA order
+	SystemID  int64     // GNSS system ID (NMEA 4.1 and later)
+	SignalID  int64     // GNSS signal ID (NMEA 4.1 and later)
+}
+
+// newGRS constructor
+func newGRS(s BaseSentence) (GRS, error) {
+	p := newParser(s)
+	p.AssertType(TypeGRS)
+	p.AssertFieldCount(14, 16)
+	m := GRS{
+		BaseSentence: s,
+		Time:         p.Time(0, "time"),
+		Mode:         p.EnumString(1, "mode", ResidualsUsedGRS, ResidualsRecomputedGRS),
+	}
+	for i := 0; i < 12; i++ {
+		m.Residuals = append(m.Residuals, p.Float64(2+i, "range residual"))
+	}
+	if len(m.Fields) > 14 {
+		m.SystemID = p.Int64(14, "system ID")
+		m.SignalID = p.Int64(15, "signal ID")
+	}
+	return m, p.Err()
+}
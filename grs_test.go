@@ -0,0 +1,67 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var grstests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  GRS
+}{
+	{
+		name: "good sentence",
+		raw:  "$GPGRS,220320.0,0,-0.8,-0.2,-0.1,-0.2,0.8,0.6,,,,,,*79",
+		msg: GRS{
+			Time:      Time{true, 22, 3, 20, 0, 0},
+			Mode:      ResidualsUsedGRS,
+			Residuals: []float64{-0.8, -0.2, -0.1, -0.2, 0.8, 0.6, 0, 0, 0, 0, 0, 0},
+		},
+	},
+	{
+		name: "good sentence with system and signal ID",
+		raw:  "$GNGRS,104148.00,1,2.6,2.2,-1.6,-1.1,-1.7,-1.5,5.8,1.7,,,,,1,1*52",
+		msg: GRS{
+			Time:      Time{true, 10, 41, 48, 0, 0},
+			Mode:      ResidualsRecomputedGRS,
+			Residuals: []float64{2.6, 2.2, -1.6, -1.1, -1.7, -1.5, 5.8, 1.7, 0, 0, 0, 0},
+			SystemID:  1,
+			SignalID:  1,
+		},
+	},
+	{
+		name: "invalid mode",
+		raw:  "$GPGRS,220320.0,2,-0.8,-0.2,-0.1,-0.2,0.8,0.6,,,,,,*7B",
+		err:  "nmea: GPGRS invalid mode: 2",
+	},
+	{
+		name: "invalid residual",
+		raw:  "$GPGRS,220320.0,0,-0.8,-0.2,-0.1,x0.2,0.8,0.6,,,,,,*2C",
+		err:  "nmea: GPGRS invalid range residual: x0.2",
+	},
+	{
+		name: "missing residuals",
+		raw:  "$GPGRS,220320.0,0,-0.8*59",
+		err:  "nmea: GPGRS invalid number of fields: 3",
+	},
+}
+
+func TestGRS(t *testing.T) {
+	for _, tt := range grstests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				grs := m.(GRS)
+				grs.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, grs)
+			}
+		})
+	}
+}
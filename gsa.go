@@ -27,24 +27,24 @@ type GSA struct {
 	VDOP    float64  // Vertical dilution of precision.
 }
 
+// gsaLayout is the field layout of GSA sentences.
+var gsaLayout = newLayout(GSA{}, TypeGSA, []int{17, 18},
+	layoutField{name: "Mode", context: "selection mode", kind: enumField, options: []string{Auto, Manual}},
+	layoutField{name: "FixType", context: "fix type", kind: enumField, options: []string{FixNone, Fix2D, Fix3D}},
+	layoutField{name: "SV", context: "satellite in view", kind: listField, width: 12},
+	layoutField{name: "PDOP", context: "pdop", kind: floatField},
+	layoutField{name: "HDOP", context: "hdop", kind: floatField},
+	layoutField{name: "VDOP", context: "vdop", kind: floatField},
+)
+
 // newGSA parses the GSA sentence into this struct.
 func newGSA(s BaseSentence) (GSA, error) {
-	p := newParser(s)
-	p.AssertType(TypeGSA)
-	m := GSA{
-		BaseSentence: s,
-		Mode:         p.EnumString(0, "selection mode", Auto, Manual),
-		FixType:      p.EnumString(1, "fix type", FixNone, Fix2D, Fix3D),
-	}
-	// Satellites in view.
-	for i := 2; i < 14; i++ {
-		if v := p.String(i, "satellite in view"); v != "" {
-			m.SV = append(m.SV, v)
-		}
-	}
-	// Dilution of precision.
-	m.PDOP = p.Float64(14, "pdop")
-	m.HDOP = p.Float64(15, "hdop")
-	m.VDOP = p.Float64(16, "vdop")
-	return m, p.Err()
+	var m GSA
+	err := gsaLayout.parse(s, &m)
+	return m, err
+}
+
+// Encode encodes m into a GSA sentence using the talker of m.
+func (m GSA) Encode() string {
+	return gsaLayout.encode(m.Talker, m)
 }
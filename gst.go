@@ -0,0 +1,44 @@
+package nmea
+
+const (
+	// TypeGST type for GST sentences
+	TypeGST = "GST"
+)
+
+// GST is the position error statistics.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_gst_gps_pseudorange_noise_statistics
+type GST struct {
+	BaseSentence
+	Time                 Time    // UTC time of the associated fix
+	RMS                  float64 // RMS value of the standard deviation of the range inputs
+	SemiMajorError       float64 // Standard deviation of semi-major axis of error ellipse in meters
+	SemiMinorError       float64 // Standard deviation of semi-minor axis of error ellipse in meters
+	SemiMajorOrientation float64 // Orientation of semi-major axis of error ellipse in degrees from true north
+	LatitudeError        float64 // Standard deviation of latitude error in meters
+	LongitudeError       float64 // Standard deviation of longitude error in meters
+	AltitudeError        float64 // Standard deviation of altitude error in meters
+}
+
+// gstLayout is the field layout of GST sentences.
+var gstLayout = newLayout(GST{}, TypeGST, []int{8},
+	layoutField{name: "Time", context: "time", kind: timeField},
+	layoutField{name: "RMS", context: "RMS", kind: floatField},
+	layoutField{name: "SemiMajorError", context: "semi-major error", kind: floatField},
+	layoutField{name: "SemiMinorError", context: "semi-minor error", kind: floatField},
+	layoutField{name: "SemiMajorOrientation", context: "semi-major orientation", kind: floatField},
+	layoutField{name: "LatitudeError", context: "latitude error", kind: floatField},
+	layoutField{name: "LongitudeError", context: "longitude error", kind: floatField},
+	layoutField{name: "AltitudeError", context: "altitude error", kind: floatField},
+)
+
+// newGST constructor
+func newGST(s BaseSentence) (GST, error) {
+	var m GST
+	err := gstLayout.parse(s, &m)
+	return m, err
+}
+
+// Encode encodes m into a GST sentence using the talker of m.
+func (m GST) Encode() string {
+	return gstLayout.encode(m.Talker, m)
+}
@@ -0,0 +1,56 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var gsttests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  GST
+}{
+	{
+		name: "good sentence",
+		raw:  "$GPGST,172814.0,0.006,0.023,0.020,273.6,0.023,0.020,0.031*6A",
+		msg: GST{
+			Time:                 Time{true, 17, 28, 14, 0, 0},
+			RMS:                  0.006,
+			SemiMajorError:       0.023,
+			SemiMinorError:       0.020,
+			SemiMajorOrientation: 273.6,
+			LatitudeError:        0.023,
+			LongitudeError:       0.020,
+			AltitudeError:        0.031,
+		},
+	},
+	{
+		name: "invalid RMS",
+		raw:  "$GPGST,172814.0,x.006,0.023,0.020,273.6,0.023,0.020,0.031*22",
+		err:  "nmea: GPGST invalid RMS: x.006",
+	},
+	{
+		name: "invalid altitude error",
+		raw:  "$GPGST,172814.0,0.006,0.023,0.020,273.6,0.023,0.020,x.031*22",
+		err:  "nmea: GPGST invalid altitude error: x.031",
+	},
+}
+
+func TestGST(t *testing.T) {
+	for _, tt := range gsttests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				gst := m.(GST)
+				gst.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, gst)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package nmea
+
+import (
+	"sort"
+	"time"
+)
+
+const (
+	// TypeHBT type for HBT sentences
+	TypeHBT = "HBT"
+	// NormalHBT equipment status character, the equipment is in normal operation
+	NormalHBT = "A"
+	// NotNormalHBT equipment status character, the equipment is not in normal operation
+	NotNormalHBT = "V"
+)
+
+// HBT is the heartbeat supervision sentence, sent at a configured interval by
+// IEC 61162-1 ed.4 devices so that listeners can supervise the connection.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_hbt_heartbeat_supervision_sentence
+type HBT struct {
+	BaseSentence
+	Interval   float64 // Configured repeat interval in seconds
+	Status     string  // Equipment status - A-normal, V-not normal
+	SequenceID int64   // Sequential sentence identifier, 0 - 9
+}
+
+// newHBT constructor
+func newHBT(s BaseSentence) (HBT, error) {
+	p := newParser(s)
+	p.AssertType(TypeHBT)
+	return HBT{
+		BaseSentence: s,
+		Interval:     p.Float64(0, "interval"),
+		Status:       p.EnumString(1, "equipment status", NormalHBT, NotNormalHBT),
+		SequenceID:   p.Int64(2, "sequence ID"),
+	}, p.Err()
+}
+
+// HeartbeatWatchdog keeps track of the HBT sentences received from each talker
+// on a stream and flags the talkers whose heartbeat is missing.
+type HeartbeatWatchdog struct {
+	deadlines map[string]time.Time
+}
+
+// Add records the heartbeat of the sentence's talker received at the given time.
+func (w *HeartbeatWatchdog) Add(m HBT, received time.Time) {
+	if w.deadlines == nil {
+		w.deadlines = map[string]time.Time{}
+	}
+	interval := time.Duration(m.Interval * float64(time.Second))
+	w.deadlines[m.Talker] = received.Add(interval)
+}
+
+// Missing returns the sorted talkers which have not sent a heartbeat within
+// their configured repeat interval at the given time.
+func (w *HeartbeatWatchdog) Missing(now time.Time) []string {
+	var missing []string
+	for talker, deadline := range w.deadlines {
+		if now.After(deadline) {
+			missing = append(missing, talker)
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
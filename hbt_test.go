@@ -0,0 +1,72 @@
+package nmea
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var hbttests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  HBT
+}{
+	{
+		name: "good sentence",
+		raw:  "$HCHBT,30.0,A,7*12",
+		msg: HBT{
+			Interval:   30,
+			Status:     NormalHBT,
+			SequenceID: 7,
+		},
+	},
+	{
+		name: "invalid interval",
+		raw:  "$HCHBT,x0.0,A,7*59",
+		err:  "nmea: HCHBT invalid interval: x0.0",
+	},
+	{
+		name: "invalid equipment status",
+		raw:  "$HCHBT,30.0,X,7*0B",
+		err:  "nmea: HCHBT invalid equipment status: X",
+	},
+}
+
+func TestHBT(t *testing.T) {
+	for _, tt := range hbttests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				hbt := m.(HBT)
+				hbt.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, hbt)
+			}
+		})
+	}
+}
+
+func TestHeartbeatWatchdog(t *testing.T) {
+	var w HeartbeatWatchdog
+	start := time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.Empty(t, w.Missing(start))
+
+	hc, err := Parse("$HCHBT,30.0,A,7*12")
+	assert.NoError(t, err)
+	gp, err := Parse("$GPHBT,1.5,V,2*2B")
+	assert.NoError(t, err)
+	w.Add(hc.(HBT), start)
+	w.Add(gp.(HBT), start)
+
+	assert.Empty(t, w.Missing(start.Add(time.Second)))
+	assert.Equal(t, []string{"GP"}, w.Missing(start.Add(2*time.Second)))
+	assert.Equal(t, []string{"GP", "HC"}, w.Missing(start.Add(31*time.Second)))
+
+	w.Add(gp.(HBT), start.Add(31*time.Second))
+	assert.Equal(t, []string{"HC"}, w.Missing(start.Add(32*time.Second)))
+}
@@ -9,18 +9,24 @@ const (
 // http://aprs.gids.nl/nmea/#hdt
 type HDT struct {
 	BaseSentence
-	Heading float64 // Heading in degrees
+	Heading Bearing // Heading in degrees
 	True    bool    // Heading is relative to true north
 }
 
+// hdtLayout is the field layout of HDT sentences.
+var hdtLayout = newLayout(HDT{}, TypeHDT, []int{2},
+	layoutField{name: "Heading", context: "heading", kind: bearingField},
+	layoutField{name: "True", context: "true", kind: flagField, options: []string{"T"}},
+)
+
 // newHDT constructor
 func newHDT(s BaseSentence) (HDT, error) {
-	p := newParser(s)
-	p.AssertType(TypeHDT)
-	m := HDT{
-		BaseSentence: s,
-		Heading:      p.Float64(0, "heading"),
-		True:         p.EnumString(1, "true", "T") == "T",
-	}
-	return m, p.Err()
+	var m HDT
+	err := hdtLayout.parse(s, &m)
+	return m, err
+}
+
+// Encode encodes m into an HDT sentence using the talker of m.
+func (m HDT) Encode() string {
+	return hdtLayout.encode(m.Talker, m)
 }
@@ -0,0 +1,45 @@
+package nmea
+
+const (
+	// TypeHSC type for HSC sentences
+	TypeHSC = "HSC"
+)
+
+// HSC is the heading steering command, the heading commanded to an autopilot.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_hsc_heading_steering_command
+type HSC struct {
+	BaseSentence
+	TrueHeading          float64 // Commanded heading in degrees true
+	TrueHeadingValid     bool    // Commanded true heading is set
+	MagneticHeading      float64 // Commanded heading in degrees magnetic
+	MagneticHeadingValid bool    // Commanded magnetic heading is set
+}
+
+// newHSC constructor
+func newHSC(s BaseSentence) (HSC, error) {
+	p := newParser(s)
+	p.AssertType(TypeHSC)
+
+	trueHeading := p.Float64(0, "true heading")
+	_ = p.EnumString(1, "true heading unit", BearingTrue)
+
+	magneticHeading := p.Float64(2, "magnetic heading")
+	_ = p.EnumString(3, "magnetic heading unit", BearingMagnetic)
+
+	return HSC{
+		BaseSentence:         s,
+		TrueHeading:          trueHeading,
+		TrueHeadingValid:     p.HasValue(0),
+		MagneticHeading:      magneticHeading,
+		MagneticHeadingValid: p.HasValue(2),
+	}, p.Err()
+}
+
+// Encode encodes the heading steering command into an HSC sentence using the talker of m.
+// A heading that is not set is encoded as a null field.
+func (m HSC) Encode() string {
+	return formatSentence(SentenceStart, m.Talker+TypeHSC, []string{
+		formatFloat(m.TrueHeading, m.TrueHeadingValid), BearingTrue,
+		formatFloat(m.MagneticHeading, m.MagneticHeadingValid), BearingMagnetic,
+	})
+}
@@ -0,0 +1,61 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var hsctests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  HSC
+}{
+	{
+		name: "good sentence",
+		raw:  "$FTHSC,40.12,T,39.11,M*5E",
+		msg: HSC{
+			TrueHeading:          40.12,
+			TrueHeadingValid:     true,
+			MagneticHeading:      39.11,
+			MagneticHeadingValid: true,
+		},
+	},
+	{
+		name: "magnetic heading only",
+		raw:  "$FTHSC,,T,39.11,M*77",
+		msg: HSC{
+			MagneticHeading:      39.11,
+			MagneticHeadingValid: true,
+		},
+	},
+	{
+		name: "invalid true heading unit",
+		raw:  "$FTHSC,40.12,X,39.11,M*52",
+		err:  "nmea: FTHSC invalid true heading unit: X",
+	},
+	{
+		name: "invalid magnetic heading",
+		raw:  "$FTHSC,40.12,T,x9.11,M*15",
+		err:  "nmea: FTHSC invalid magnetic heading: x9.11",
+	},
+}
+
+func TestHSC(t *testing.T) {
+	for _, tt := range hsctests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				hsc := m.(HSC)
+				assert.Equal(t, tt.raw, hsc.Encode())
+				hsc.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, hsc)
+			}
+		})
+	}
+}
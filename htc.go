@@ -0,0 +1,72 @@
+package nmea
+
+const (
+	// TypeHTC type for HTC sentences
+	TypeHTC = "HTC"
+	// InUseHTC override and status character
+	InUseHTC = "A"
+	// NotInUseHTC override and status character
+	NotInUseHTC = "V"
+	// ManualHTC steering mode character, manual steering
+	ManualHTC = "M"
+	// StandAloneHTC steering mode character, stand-alone heading control
+	StandAloneHTC = "S"
+	// HeadingControlHTC steering mode character, heading control
+	HeadingControlHTC = "H"
+	// TrackControlHTC steering mode character, track control
+	TrackControlHTC = "T"
+	// RudderControlHTC steering mode character, rudder control
+	RudderControlHTC = "R"
+	// RadiusControlledHTC turn mode character, radius controlled
+	RadiusControlledHTC = "R"
+	// RateControlledHTC turn mode character, turn rate controlled
+	RateControlledHTC = "T"
+	// NotControlledHTC turn mode character, turn not controlled
+	NotControlledHTC = "N"
+)
+
+// HTC is the heading/track control command sent to an autopilot.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_htc_heading_track_control_command
+type HTC struct {
+	BaseSentence
+	Override         string  // Override - A-in use, V-not in use
+	RudderAngle      float64 // Commanded rudder angle in degrees
+	RudderDirection  string  // Commanded rudder direction - L-port, R-starboard
+	SteeringMode     string  // Selected steering mode - M-manual, S-stand-alone, H-heading control, T-track control, R-rudder control
+	TurnMode         string  // Turn mode - R-radius controlled, T-turn rate controlled, N-turn not controlled
+	RudderLimit      float64 // Commanded rudder limit in degrees
+	OffHeadingLimit  float64 // Commanded off-heading limit in degrees
+	RadiusOfTurn     float64 // Commanded radius of turn for heading changes in nautical miles
+	RateOfTurn       float64 // Commanded rate of turn for heading changes in degrees per minute
+	HeadingToSteer   float64 // Commanded heading to steer in degrees
+	OffTrackLimit    float64 // Commanded off-track limit in nautical miles
+	Track            float64 // Commanded track in degrees
+	HeadingReference string  // Heading reference in use - T-true, M-magnetic
+}
+
+// newHTC constructor
+func newHTC(s BaseSentence) (HTC, error) {
+	p := newParser(s)
+	p.AssertType(TypeHTC)
+	return parseHTC(p), p.Err()
+}
+
+// parseHTC parses the field layout shared by the HTC and HTD sentences.
+func parseHTC(p *parser) HTC {
+	return HTC{
+		BaseSentence:     p.BaseSentence,
+		Override:         p.EnumString(0, "override", InUseHTC, NotInUseHTC),
+		RudderAngle:      p.Float64(1, "rudder angle"),
+		RudderDirection:  p.EnumString(2, "rudder direction", Left, Right),
+		SteeringMode:     p.EnumString(3, "steering mode", ManualHTC, StandAloneHTC, HeadingControlHTC, TrackControlHTC, RudderControlHTC),
+		TurnMode:         p.EnumString(4, "turn mode", RadiusControlledHTC, RateControlledHTC, NotControlledHTC),
+		RudderLimit:      p.Float64(5, "rudder limit"),
+		OffHeadingLimit:  p.Float64(6, "off-heading limit"),
+		RadiusOfTurn:     p.Float64(7, "radius of turn"),
+		RateOfTurn:       p.Float64(8, "rate of turn"),
+		HeadingToSteer:   p.Float64(9, "heading to steer"),
+		OffTrackLimit:    p.Float64(10, "off-track limit"),
+		Track:            p.Float64(11, "track"),
+		HeadingReference: p.EnumString(12, "heading reference", BearingTrue, BearingMagnetic),
+	}
+}
@@ -0,0 +1,54 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var htctests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  HTC
+}{
+	{
+		name: "good sentence",
+		raw:  "$AGHTC,V,10.0,L,H,N,30.0,15.0,0.5,30.0,90.0,,,T*04",
+		msg: HTC{
+			Override:         NotInUseHTC,
+			RudderAngle:      10,
+			RudderDirection:  Left,
+			SteeringMode:     HeadingControlHTC,
+			TurnMode:         NotControlledHTC,
+			RudderLimit:      30,
+			OffHeadingLimit:  15,
+			RadiusOfTurn:     0.5,
+			RateOfTurn:       30,
+			HeadingToSteer:   90,
+			HeadingReference: BearingTrue,
+		},
+	},
+	{
+		name: "invalid steering mode",
+		raw:  "$AGHTC,V,10.0,L,X,N,30.0,15.0,0.5,30.0,90.0,,,T*14",
+		err:  "nmea: AGHTC invalid steering mode: X",
+	},
+}
+
+func TestHTC(t *testing.T) {
+	for _, tt := range htctests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				htc := m.(HTC)
+				htc.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, htc)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package nmea
+
+const (
+	// TypeHTD type for HTD sentences
+	TypeHTD = "HTD"
+)
+
+// HTD is the heading/track control data reported by an autopilot. It has the
+// fields of HTC followed by the autopilot status.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_htd_heading_track_control_data
+type HTD struct {
+	HTC
+	RudderStatus     string  // Rudder limit status - A-limit reached, V-not reached
+	OffHeadingStatus string  // Off-heading status - A-limit reached, V-not reached
+	OffTrackStatus   string  // Off-track status - A-limit reached, V-not reached
+	Heading          float64 // Vessel heading in degrees
+}
+
+// newHTD constructor
+func newHTD(s BaseSentence) (HTD, error) {
+	p := newParser(s)
+	p.AssertType(TypeHTD)
+	return HTD{
+		HTC:              parseHTC(p),
+		RudderStatus:     p.EnumString(13, "rudder status", InUseHTC, NotInUseHTC),
+		OffHeadingStatus: p.EnumString(14, "off-heading status", InUseHTC, NotInUseHTC),
+		OffTrackStatus:   p.EnumString(15, "off-track status", InUseHTC, NotInUseHTC),
+		Heading:          p.Float64(16, "heading"),
+	}, p.Err()
+}
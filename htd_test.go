@@ -0,0 +1,62 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var htdtests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  HTD
+}{
+	{
+		name: "good sentence",
+		raw:  "$AGHTD,V,10.0,L,T,R,30.0,15.0,0.5,30.0,90.0,0.1,92.0,T,V,V,A,88.5*63",
+		msg: HTD{
+			HTC: HTC{
+				Override:         NotInUseHTC,
+				RudderAngle:      10,
+				RudderDirection:  Left,
+				SteeringMode:     TrackControlHTC,
+				TurnMode:         RadiusControlledHTC,
+				RudderLimit:      30,
+				OffHeadingLimit:  15,
+				RadiusOfTurn:     0.5,
+				RateOfTurn:       30,
+				HeadingToSteer:   90,
+				OffTrackLimit:    0.1,
+				Track:            92,
+				HeadingReference: BearingTrue,
+			},
+			RudderStatus:     NotInUseHTC,
+			OffHeadingStatus: NotInUseHTC,
+			OffTrackStatus:   InUseHTC,
+			Heading:          88.5,
+		},
+	},
+	{
+		name: "invalid heading",
+		raw:  "$AGHTD,V,10.0,L,T,R,30.0,15.0,0.5,30.0,90.0,0.1,92.0,T,V,V,A,x*00",
+		err:  "nmea: AGHTD invalid heading: x",
+	},
+}
+
+func TestHTD(t *testing.T) {
+	for _, tt := range htdtests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				htd := m.(HTD)
+				htd.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, htd)
+			}
+		})
+	}
+}
@@ -0,0 +1,280 @@
+package nmea
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// fieldKind is the kind of value held by a layout field.
+type fieldKind int
+
+const (
+	stringField    fieldKind = iota // plain string
+	enumField                       // string restricted to the options
+	intField                        // integer
+	floatField                      // decimal number
+	bearingField                    // decimal number normalized by NewBearing
+	timeField                       // Time
+	dateField                       // Date
+	latitudeField                   // Latitude from a coordinate and a hemisphere field
+	longitudeField                  // Longitude from a coordinate and a hemisphere field
+	signedField                     // decimal number negated if the direction field is options[1]
+	flagField                       // bool set if the field is options[0]
+	unitField                       // constant options[0], written on encode and not stored
+	listField                       // non-empty strings of width fields
+)
+
+var fieldKindNames = map[fieldKind]string{
+	stringField:    "string",
+	enumField:      "enum",
+	intField:       "int",
+	floatField:     "float",
+	bearingField:   "bearing",
+	timeField:      "time",
+	dateField:      "date",
+	latitudeField:  "latitude",
+	longitudeField: "longitude",
+	signedField:    "signed float",
+	flagField:      "flag",
+	unitField:      "unit",
+	listField:      "list",
+}
+
+// layoutField describes a value of a sentence and the fields it is read from.
+type layoutField struct {
+	name      string    // struct field name, empty for unit fields
+	context   string    // context used in errors
+	kind      fieldKind // kind of value
+	options   []string  // enum values, direction or unit characters
+	direction string    // context of the direction field of a signed value
+	width     int       // number of fields of a list
+
+	index int   // index of the first sentence field, set by newLayout
+	field []int // index of the struct field, set by newLayout
+}
+
+// size returns the number of sentence fields the value is read from.
+func (f layoutField) size() int {
+	switch f.kind {
+	case latitudeField, longitudeField, signedField:
+		return 2
+	case listField:
+		return f.width
+	}
+	return 1
+}
+
+// layout is the declarative description of a fixed-layout sentence.
+// The same layout drives parsing and encoding, so both always agree on
+// the field order and contexts.
+type layout struct {
+	typ    string
+	counts []int
+	fields []layoutField
+	base   []int
+}
+
+// newLayout compiles the layout of the sentence struct of sample.
+// The fields are given in sentence order and must describe exactly the
+// first of counts sentence fields.
+// It panics if a field does not exist in the struct, as layouts are built
+// once at init and a mismatch is a programming error.
+func newLayout(sample interface{}, typ string, counts []int, fields ...layoutField) *layout {
+	t := reflect.TypeOf(sample)
+	l := &layout{typ: typ, counts: counts, fields: fields}
+	base, ok := t.FieldByName("BaseSentence")
+	if !ok {
+		panic(fmt.Sprintf("nmea: %s layout: %s has no BaseSentence", typ, t))
+	}
+	l.base = base.Index
+	index := 0
+	for i := range l.fields {
+		f := &l.fields[i]
+		f.index = index
+		index += f.size()
+		if f.kind == unitField {
+			continue
+		}
+		sf, ok := t.FieldByName(f.name)
+		if !ok {
+			panic(fmt.Sprintf("nmea: %s layout: %s has no field %s", typ, t, f.name))
+		}
+		f.field = sf.Index
+	}
+	if len(counts) > 0 && index != counts[0] {
+		panic(fmt.Sprintf("nmea: %s layout: %d fields described, want %d", typ, index, counts[0]))
+	}
+	return l
+}
+
+// parse parses s into the sentence struct pointed to by dst.
+func (l *layout) parse(s BaseSentence, dst interface{}) error {
+	p := newParser(s)
+	p.AssertType(l.typ)
+	p.AssertFieldCount(l.counts...)
+	v := reflect.ValueOf(dst).Elem()
+	// Values are set through pointers, which unlike boxed structs do not allocate.
+	*v.FieldByIndex(l.base).Addr().Interface().(*BaseSentence) = s
+	for _, f := range l.fields {
+		if f.kind == unitField {
+			continue
+		}
+		fv := v.FieldByIndex(f.field)
+		i := f.index
+		switch f.kind {
+		case stringField:
+			fv.SetString(p.String(i, f.context))
+		case enumField:
+			fv.SetString(p.EnumString(i, f.context, f.options...))
+		case intField:
+			fv.SetInt(p.Int64(i, f.context))
+		case floatField:
+			fv.SetFloat(p.Float64(i, f.context))
+		case bearingField:
+			fv.SetFloat(float64(NewBearing(p.Float64(i, f.context))))
+		case timeField:
+			*fv.Addr().Interface().(*Time) = p.Time(i, f.context)
+		case dateField:
+			*fv.Addr().Interface().(*Date) = p.Date(i, f.context)
+		case latitudeField, longitudeField:
+			fv.SetFloat(p.LatLong(i, i+1, f.context))
+		case signedField:
+			n := p.Float64(i, f.context)
+			if p.EnumString(i+1, f.direction, f.options...) == f.options[1] {
+				n = 0 - n
+			}
+			fv.SetFloat(n)
+		case flagField:
+			fv.SetBool(p.EnumString(i, f.context, f.options[0]) == f.options[0])
+		case listField:
+			var list []string
+			for j := i; j < i+f.width; j++ {
+				if s := p.String(j, f.context); s != "" {
+					list = append(list, s)
+				}
+			}
+			*fv.Addr().Interface().(*[]string) = list
+		}
+	}
+	return p.Err()
+}
+
+// encode encodes the sentence struct src into a sentence using the given talker.
+func (l *layout) encode(talker string, src interface{}) string {
+	v := reflect.ValueOf(src)
+	fields := make([]string, 0, l.counts[0])
+	for _, f := range l.fields {
+		if f.kind == unitField {
+			fields = append(fields, f.options[0])
+			continue
+		}
+		fv := v.FieldByIndex(f.field)
+		switch f.kind {
+		case stringField, enumField:
+			fields = append(fields, fv.String())
+		case intField:
+			fields = append(fields, strconv.FormatInt(fv.Int(), 10))
+		case floatField, bearingField:
+			fields = append(fields, formatFloat(fv.Float(), true))
+		case timeField:
+			fields = append(fields, formatTime(fv.Interface().(Time)))
+		case dateField:
+			fields = append(fields, formatDate(fv.Interface().(Date)))
+		case latitudeField:
+			fields = append(fields, formatCoordinate(fv.Float(), 2, North, South)...)
+		case longitudeField:
+			fields = append(fields, formatCoordinate(fv.Float(), 3, East, West)...)
+		case signedField:
+			n := fv.Float()
+			switch {
+			case n > 0:
+				fields = append(fields, formatFloat(n, true), f.options[0])
+			case n < 0:
+				fields = append(fields, formatFloat(0-n, true), f.options[1])
+			default:
+				fields = append(fields, "", "")
+			}
+		case flagField:
+			var s string
+			if fv.Bool() {
+				s = f.options[0]
+			}
+			fields = append(fields, s)
+		case listField:
+			list := fv.Interface().([]string)
+			for j := 0; j < f.width; j++ {
+				var s string
+				if j < len(list) {
+					s = list[j]
+				}
+				fields = append(fields, s)
+			}
+		}
+	}
+	return formatSentence(SentenceStart, talker+l.typ, fields)
+}
+
+// describe returns one line per value of the layout with the sentence
+// fields it is read from, its kind and its options, for documentation.
+func (l *layout) describe() []string {
+	lines := make([]string, 0, len(l.fields))
+	for _, f := range l.fields {
+		fields := strconv.Itoa(f.index)
+		if n := f.size(); n > 1 {
+			fields += "-" + strconv.Itoa(f.index+n-1)
+		}
+		name := f.name
+		if f.kind == unitField {
+			name = "-"
+		}
+		line := fmt.Sprintf("%s %s %s: %s", l.typ, fields, name, fieldKindNames[f.kind])
+		if len(f.options) > 0 {
+			line += " (" + strings.Join(f.options, ", ") + ")"
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// formatTime formats a Time as hhmmss with the fraction of the second if it is set.
+// An invalid Time is encoded as a null field.
+func formatTime(t Time) string {
+	if !t.Valid {
+		return ""
+	}
+	s := fmt.Sprintf("%02d%02d%02d", t.Hour, t.Minute, t.Second)
+	if ns := t.nanoseconds(); ns > 0 {
+		s += strings.TrimRight(fmt.Sprintf(".%09d", ns), "0")
+	}
+	return s
+}
+
+// formatDate formats a Date as ddmmyy. An invalid Date is encoded as a null field.
+func formatDate(d Date) string {
+	if !d.Valid {
+		return ""
+	}
+	return fmt.Sprintf("%02d%02d%02d", d.DD, d.MM, d.YY)
+}
+
+// formatCoordinate formats a coordinate in degrees as the GPS coordinate and
+// hemisphere fields, with the given number of degree digits. The minutes are
+// rounded to 6 decimals, which keeps the value of any received coordinate.
+func formatCoordinate(v float64, digits int, positive, negative string) []string {
+	hemisphere := positive
+	if v < 0 {
+		hemisphere = negative
+		v = 0 - v
+	}
+	const scale = 1e6
+	total := int64(math.Floor(v*60*scale + 0.5))
+	degrees := total / (60 * scale)
+	minutes := total % (60 * scale)
+	return []string{
+		fmt.Sprintf("%0*d%02d.%06d", digits, degrees, minutes/scale, minutes%scale),
+		hemisphere,
+	}
+}
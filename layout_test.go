@@ -0,0 +1,61 @@
+package nmea
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// withoutBase returns a copy of the sentence struct s with the BaseSentence cleared.
+func withoutBase(s Sentence) interface{} {
+	v := reflect.New(reflect.TypeOf(s)).Elem()
+	v.Set(reflect.ValueOf(s))
+	base := v.FieldByName("BaseSentence")
+	base.Set(reflect.Zero(base.Type()))
+	return v.Interface()
+}
+
+func TestLayoutRoundTrip(t *testing.T) {
+	for _, raw := range []string{
+		"$GNRMC,220516,A,5133.82,N,00042.24,W,173.8,231.8,130694,004.2,W*6E",
+		"$GNRMC,142754.0,A,4302.539570,N,07920.379823,W,0.0,,070617,0.0,E,A*21",
+		"$GNGGA,203415.000,6325.6138,N,01021.4290,E,1,8,2.42,72.5,M,41.5,M,,*7C",
+		"$GPGSA,A,3,22,19,18,27,14,03,,,,,,,3.1,2.0,2.4*36",
+		"$GPGLL,3926.7952,N,12000.5947,W,022732,A,A*58",
+		"$GPVTG,45.5,T,67.5,M,30.45,N,56.40,K*4B",
+		"$GPHDT,123.456,T*32",
+		"$INTHS,123.456,A*20",
+		"$GPZDA,172809.456,12,07,1996,00,00*57",
+		"$GPGST,172814.0,0.006,0.023,0.020,273.6,0.023,0.020,0.031*6A",
+		"$GPDTM,999,CH,0.08,N,0.07,E,-47.7,W84*10",
+	} {
+		t.Run(raw, func(t *testing.T) {
+			s, err := Parse(raw)
+			assert.NoError(t, err)
+			e, ok := s.(interface{ Encode() string })
+			assert.True(t, ok)
+			encoded, err := Parse(e.Encode())
+			assert.NoError(t, err)
+			assert.Equal(t, withoutBase(s), withoutBase(encoded))
+		})
+	}
+}
+
+func TestLayoutEncode(t *testing.T) {
+	s, err := Parse("$GNGGA,203415.000,6325.6138,N,01021.4290,E,1,8,2.42,72.5,M,41.5,M,,*7C")
+	assert.NoError(t, err)
+	assert.Equal(t, "$GNGGA,203415,6325.613800,N,01021.429000,E,1,8,2.42,72.5,M,41.5,M,,*62", s.(GGA).Encode())
+
+	m := GSA{BaseSentence: BaseSentence{Talker: "GP"}, Mode: Auto, FixType: Fix3D, SV: []string{"22", "19"}, PDOP: 3.1, HDOP: 2, VDOP: 2.4}
+	assert.Equal(t, "$GPGSA,A,3,22,19,,,,,,,,,,,3.1,2,2.4*22", m.Encode())
+}
+
+func TestLayoutDescribe(t *testing.T) {
+	assert.Equal(t, []string{
+		"GLL 0-1 Latitude: latitude",
+		"GLL 2-3 Longitude: longitude",
+		"GLL 4 Time: time",
+		"GLL 5 Validity: enum (A, V)",
+	}, gllLayout.describe())
+}
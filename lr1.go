@@ -0,0 +1,33 @@
+package nmea
+
+const (
+	// TypeLR1 type for LR1 sentences
+	TypeLR1 = "LR1"
+)
+
+// LR1 is the AIS long-range reply sentence 1, the ship's identification.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_lr1_ais_long_range_reply_sentence_1
+type LR1 struct {
+	BaseSentence
+	SequenceNumber int64  // Sequence number, links the LR1, LR2 and LR3 sentences of a reply
+	ResponderMMSI  string // MMSI of the responder
+	RequestorMMSI  string // MMSI of the requestor
+	ShipName       string // Ship's name
+	CallSign       string // Call sign
+	IMONumber      string // IMO number
+}
+
+// newLR1 constructor
+func newLR1(s BaseSentence) (LR1, error) {
+	p := newParser(s)
+	p.AssertType(TypeLR1)
+	return LR1{
+		BaseSentence:   s,
+		SequenceNumber: p.Int64(0, "sequence number"),
+		ResponderMMSI:  p.String(1, "responder MMSI"),
+		RequestorMMSI:  p.String(2, "requestor MMSI"),
+		ShipName:       p.String(3, "ship name"),
+		CallSign:       p.String(4, "call sign"),
+		IMONumber:      p.String(5, "IMO number"),
+	}, p.Err()
+}
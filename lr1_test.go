@@ -0,0 +1,49 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var lr1tests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  LR1
+}{
+	{
+		name: "good sentence",
+		raw:  "$LRLR1,1,211000001,002190000,EXAMPLE SHIP,DABC,9123456*59",
+		msg: LR1{
+			SequenceNumber: 1,
+			ResponderMMSI:  "211000001",
+			RequestorMMSI:  "002190000",
+			ShipName:       "EXAMPLE SHIP",
+			CallSign:       "DABC",
+			IMONumber:      "9123456",
+		},
+	},
+	{
+		name: "invalid sequence number",
+		raw:  "$LRLR1,x,211000001,002190000,EXAMPLE SHIP,DABC,9123456*10",
+		err:  "nmea: LRLR1 invalid sequence number: x",
+	},
+}
+
+func TestLR1(t *testing.T) {
+	for _, tt := range lr1tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				lr1 := m.(LR1)
+				lr1.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, lr1)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package nmea
+
+import "strconv"
+
+const (
+	// TypeLR2 type for LR2 sentences
+	TypeLR2 = "LR2"
+)
+
+// LR2 is the AIS long-range reply sentence 2, the ship's position, course and speed.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_lr2_ais_long_range_reply_sentence_2
+type LR2 struct {
+	BaseSentence
+	SequenceNumber int64     // Sequence number, links the LR1, LR2 and LR3 sentences of a reply
+	ResponderMMSI  string    // MMSI of the responder
+	Day            int64     // Day of the position, 01 - 31
+	Month          int64     // Month of the position, 01 - 12
+	Year           int64     // Year of the position
+	Time           Time      // UTC time of the position
+	Latitude       Latitude  // Latitude
+	Longitude      Longitude // Longitude
+	Course         float64   // True course over ground
+	Speed          float64   // Speed over ground in knots
+}
+
+// newLR2 constructor
+func newLR2(s BaseSentence) (LR2, error) {
+	p := newParser(s)
+	p.AssertType(TypeLR2)
+
+	sequenceNumber := p.Int64(0, "sequence number")
+	responderMMSI := p.String(1, "responder MMSI")
+
+	var day, month, year int64
+	if date := p.String(2, "date"); len(date) == 8 {
+		var err1, err2, err3 error
+		day, err1 = strconv.ParseInt(date[0:2], 10, 64)
+		month, err2 = strconv.ParseInt(date[2:4], 10, 64)
+		year, err3 = strconv.ParseInt(date[4:8], 10, 64)
+		if err1 != nil || err2 != nil || err3 != nil {
+			p.SetErr("date", date)
+		}
+	} else if date != "" {
+		p.SetErr("date", date)
+	}
+
+	time := p.Time(3, "time")
+	latitude := p.Latitude(4, 5, "latitude")
+	longitude := p.Longitude(6, 7, "longitude")
+
+	course := p.Float64(8, "course")
+	_ = p.EnumString(9, "course unit", BearingTrue)
+
+	speed := p.Float64(10, "speed")
+	_ = p.EnumString(11, "speed unit", SpeedKnots)
+
+	return LR2{
+		BaseSentence:   s,
+		SequenceNumber: sequenceNumber,
+		ResponderMMSI:  responderMMSI,
+		Day:            day,
+		Month:          month,
+		Year:           year,
+		Time:           time,
+		Latitude:       latitude,
+		Longitude:      longitude,
+		Course:         course,
+		Speed:          speed,
+	}, p.Err()
+}
+
+// Position returns the reported position.
+func (m LR2) Position() Position {
+	return Position{Latitude: m.Latitude, Longitude: m.Longitude}
+}
@@ -0,0 +1,58 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var lr2tests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  LR2
+}{
+	{
+		name: "good sentence",
+		raw:  "$LRLR2,1,211000001,14102026,123519.00,5433.50,N,01022.20,E,283.5,T,12.3,N*0B",
+		msg: LR2{
+			SequenceNumber: 1,
+			ResponderMMSI:  "211000001",
+			Day:            14,
+			Month:          10,
+			Year:           2026,
+			Time:           Time{true, 12, 35, 19, 0, 0},
+			Latitude:       Latitude(MustParseGPS("5433.50 N")),
+			Longitude:      Longitude(MustParseGPS("01022.20 E")),
+			Course:         283.5,
+			Speed:          12.3,
+		},
+	},
+	{
+		name: "invalid date",
+		raw:  "$LRLR2,1,211000001,141026,123519.00,5433.50,N,01022.20,E,283.5,T,12.3,N*09",
+		err:  "nmea: LRLR2 invalid date: 141026",
+	},
+	{
+		name: "invalid course unit",
+		raw:  "$LRLR2,1,211000001,14102026,123519.00,5433.50,N,01022.20,E,283.5,M,12.3,N*12",
+		err:  "nmea: LRLR2 invalid course unit: M",
+	},
+}
+
+func TestLR2(t *testing.T) {
+	for _, tt := range lr2tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				lr2 := m.(LR2)
+				lr2.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, lr2)
+			}
+		})
+	}
+}
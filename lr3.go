@@ -0,0 +1,43 @@
+package nmea
+
+const (
+	// TypeLR3 type for LR3 sentences
+	TypeLR3 = "LR3"
+)
+
+// LR3 is the AIS long-range reply sentence 3, the ship's voyage and static data.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_lr3_ais_long_range_reply_sentence_3
+type LR3 struct {
+	BaseSentence
+	SequenceNumber int64   // Sequence number, links the LR1, LR2 and LR3 sentences of a reply
+	ResponderMMSI  string  // MMSI of the responder
+	Destination    string  // Voyage destination
+	ETADate        Date    // ETA date
+	ETATime        Time    // ETA time
+	Draught        float64 // Draught in meters
+	ShipCargo      int64   // Ship and cargo type, ITU-R M.1371
+	ShipLength     float64 // Ship length in meters
+	ShipBreadth    float64 // Ship breadth in meters
+	ShipType       string  // Ship type
+	PersonsOnBoard int64   // Number of persons on board
+}
+
+// newLR3 constructor
+func newLR3(s BaseSentence) (LR3, error) {
+	p := newParser(s)
+	p.AssertType(TypeLR3)
+	return LR3{
+		BaseSentence:   s,
+		SequenceNumber: p.Int64(0, "sequence number"),
+		ResponderMMSI:  p.String(1, "responder MMSI"),
+		Destination:    p.String(2, "destination"),
+		ETADate:        p.Date(3, "ETA date"),
+		ETATime:        p.Time(4, "ETA time"),
+		Draught:        p.Float64(5, "draught"),
+		ShipCargo:      p.Int64(6, "ship and cargo type"),
+		ShipLength:     p.Float64(7, "ship length"),
+		ShipBreadth:    p.Float64(8, "ship breadth"),
+		ShipType:       p.String(9, "ship type"),
+		PersonsOnBoard: p.Int64(10, "persons on board"),
+	}, p.Err()
+}
@@ -0,0 +1,54 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var lr3tests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  LR3
+}{
+	{
+		name: "good sentence",
+		raw:  "$LRLR3,1,211000001,HAMBURG,161026,060000.00,8.5,70,180.0,32.0,70,24*6C",
+		msg: LR3{
+			SequenceNumber: 1,
+			ResponderMMSI:  "211000001",
+			Destination:    "HAMBURG",
+			ETADate:        Date{true, 16, 10, 26},
+			ETATime:        Time{true, 6, 0, 0, 0, 0},
+			Draught:        8.5,
+			ShipCargo:      70,
+			ShipLength:     180,
+			ShipBreadth:    32,
+			ShipType:       "70",
+			PersonsOnBoard: 24,
+		},
+	},
+	{
+		name: "invalid draught",
+		raw:  "$LRLR3,1,211000001,HAMBURG,161026,060000.00,x,70,180.0,32.0,70,24*37",
+		err:  "nmea: LRLR3 invalid draught: x",
+	},
+}
+
+func TestLR3(t *testing.T) {
+	for _, tt := range lr3tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				lr3 := m.(LR3)
+				lr3.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, lr3)
+			}
+		})
+	}
+}
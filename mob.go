@@ -0,0 +1,72 @@
+package nmea
+
+const (
+	// TypeMOB type for MOB sentences
+	TypeMOB = "MOB"
+	// ActivatedMOB status character, the MOB emitter has been activated
+	ActivatedMOB = "A"
+	// TestModeMOB status character, the MOB emitter is in test mode
+	TestModeMOB = "T"
+	// ManualMOB status character, the MOB was raised by a manual button
+	ManualMOB = "M"
+	// NotInUseMOB status character, the MOB emitter is not in use
+	NotInUseMOB = "V"
+	// ErrorMOB status character
+	ErrorMOB = "E"
+	// EstimatedPositionMOB position source character, the position is estimated by the vessel
+	EstimatedPositionMOB = "0"
+	// ReportedPositionMOB position source character, the position is reported by the MOB emitter
+	ReportedPositionMOB = "1"
+	// ErrorPositionMOB position source character
+	ErrorPositionMOB = "6"
+	// BatteryGoodMOB battery status character
+	BatteryGoodMOB = "0"
+	// BatteryLowMOB battery status character
+	BatteryLowMOB = "1"
+	// BatteryErrorMOB battery status character
+	BatteryErrorMOB = "6"
+)
+
+// MOB is the man over board notification (NMEA 4.11 and later).
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_mob_man_over_board_notification
+type MOB struct {
+	BaseSentence
+	EmitterID      string    // MOB emitter identification (5 hex digits)
+	Status         string    // MOB status - A-activated, T-test, M-manual, V-not in use, E-error
+	ActivationTime Time      // MOB activation UTC time
+	PositionSource string    // MOB position source - 0-estimated by vessel, 1-reported by emitter, 6-error
+	Date           Date      // Date of position
+	Time           Time      // UTC time of position
+	Latitude       Latitude  // Latitude
+	Longitude      Longitude // Longitude
+	Course         float64   // Course over ground in degrees true
+	Speed          float64   // Speed over ground in knots
+	MMSI           string    // MMSI of the vessel
+	BatteryStatus  string    // Battery status - 0-good, 1-low, 6-error
+}
+
+// newMOB constructor
+func newMOB(s BaseSentence) (MOB, error) {
+	p := newParser(s)
+	p.AssertType(TypeMOB)
+	return MOB{
+		BaseSentence:   s,
+		EmitterID:      p.String(0, "emitter ID"),
+		Status:         p.EnumString(1, "status", ActivatedMOB, TestModeMOB, ManualMOB, NotInUseMOB, ErrorMOB),
+		ActivationTime: p.Time(2, "activation time"),
+		PositionSource: p.EnumString(3, "position source", EstimatedPositionMOB, ReportedPositionMOB, ErrorPositionMOB),
+		Date:           p.Date(4, "date"),
+		Time:           p.Time(5, "time"),
+		Latitude:       p.Latitude(6, 7, "latitude"),
+		Longitude:      p.Longitude(8, 9, "longitude"),
+		Course:         p.Float64(10, "course"),
+		Speed:          p.Float64(11, "speed"),
+		MMSI:           p.String(12, "MMSI"),
+		BatteryStatus:  p.EnumString(13, "battery status", BatteryGoodMOB, BatteryLowMOB, BatteryErrorMOB),
+	}, p.Err()
+}
+
+// Position returns the reported position.
+func (m MOB) Position() Position {
+	return Position{Latitude: m.Latitude, Longitude: m.Longitude}
+}
@@ -0,0 +1,65 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var mobtests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  MOB
+}{
+	{
+		name: "good sentence",
+		raw:  "$CRMOB,14DA8,A,105147.00,1,230617,105230.00,5953.5312,N,01042.5321,E,85.2,0.4,257799123,0*11",
+		msg: MOB{
+			EmitterID:      "14DA8",
+			Status:         ActivatedMOB,
+			ActivationTime: Time{true, 10, 51, 47, 0, 0},
+			PositionSource: ReportedPositionMOB,
+			Date:           Date{true, 23, 6, 17},
+			Time:           Time{true, 10, 52, 30, 0, 0},
+			Latitude:       Latitude(MustParseGPS("5953.5312 N")),
+			Longitude:      Longitude(MustParseGPS("01042.5321 E")),
+			Course:         85.2,
+			Speed:          0.4,
+			MMSI:           "257799123",
+			BatteryStatus:  BatteryGoodMOB,
+		},
+	},
+	{
+		name: "invalid status",
+		raw:  "$CRMOB,14DA8,X,105147.00,1,230617,105230.00,5953.5312,N,01042.5321,E,85.2,0.4,257799123,0*08",
+		err:  "nmea: CRMOB invalid status: X",
+	},
+	{
+		name: "invalid position source",
+		raw:  "$CRMOB,14DA8,A,105147.00,9,230617,105230.00,5953.5312,N,01042.5321,E,85.2,0.4,257799123,0*19",
+		err:  "nmea: CRMOB invalid position source: 9",
+	},
+	{
+		name: "invalid date",
+		raw:  "$CRMOB,14DA8,A,105147.00,1,2306x7,105230.00,5953.5312,N,01042.5321,E,85.2,0.4,257799123,0*58",
+		err:  "nmea: CRMOB invalid date: 2306x7",
+	},
+}
+
+func TestMOB(t *testing.T) {
+	for _, tt := range mobtests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				mob := m.(MOB)
+				mob.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, mob)
+			}
+		})
+	}
+}
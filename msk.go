@@ -0,0 +1,47 @@
+package nmea
+
+const (
+	// TypeMSK type for MSK sentences
+	TypeMSK = "MSK"
+	// AutoMSK selection character, automatic selection
+	AutoMSK = "A"
+	// ManualMSK selection character, manual selection
+	ManualMSK = "M"
+	// StatusReportMSK sentence status flag character, the sentence is a status report
+	StatusReportMSK = "R"
+	// StatusConfigurationMSK sentence status flag character, the sentence is a configuration command
+	StatusConfigurationMSK = "C"
+)
+
+// MSK is the command to, or status of, a DGPS beacon (MSK) receiver.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_msk_msk_receiver_interface
+type MSK struct {
+	BaseSentence
+	Frequency      float64 // Beacon frequency in kHz
+	FrequencyMode  string  // Frequency selection - A-auto, M-manual
+	BitRate        int64   // Beacon bit rate in bits per second
+	BitRateMode    string  // Bit rate selection - A-auto, M-manual
+	StatusInterval int64   // Interval for sending MSS status in seconds
+	Channel        int64   // Channel number (NMEA 3.0 and later)
+	SentenceStatus string  // Sentence status flag - R-status report, C-configuration command
+}
+
+// newMSK constructor
+func newMSK(s BaseSentence) (MSK, error) {
+	p := newParser(s)
+	p.AssertType(TypeMSK)
+	p.AssertFieldCount(5, 7)
+	m := MSK{
+		BaseSentence:   s,
+		Frequency:      p.Float64(0, "frequency"),
+		FrequencyMode:  p.EnumString(1, "frequency mode", AutoMSK, ManualMSK),
+		BitRate:        p.Int64(2, "bit rate"),
+		BitRateMode:    p.EnumString(3, "bit rate mode", AutoMSK, ManualMSK),
+		StatusInterval: p.Int64(4, "status interval"),
+	}
+	if len(m.Fields) > 5 {
+		m.Channel = p.Int64(5, "channel")
+		m.SentenceStatus = p.EnumString(6, "sentence status", StatusReportMSK, StatusConfigurationMSK)
+	}
+	return m, p.Err()
+}
@@ -0,0 +1,71 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var msktests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  MSK
+}{
+	{
+		name: "good sentence",
+		raw:  "$GPMSK,318.0,A,100,M,2*45",
+		msg: MSK{
+			Frequency:      318.0,
+			FrequencyMode:  AutoMSK,
+			BitRate:        100,
+			BitRateMode:    ManualMSK,
+			StatusInterval: 2,
+		},
+	},
+	{
+		name: "good sentence with channel and status",
+		raw:  "$GPMSK,318.0,A,100,M,2,1,R*26",
+		msg: MSK{
+			Frequency:      318.0,
+			FrequencyMode:  AutoMSK,
+			BitRate:        100,
+			BitRateMode:    ManualMSK,
+			StatusInterval: 2,
+			Channel:        1,
+			SentenceStatus: StatusReportMSK,
+		},
+	},
+	{
+		name: "invalid frequency mode",
+		raw:  "$GPMSK,318.0,X,100,M,2*5C",
+		err:  "nmea: GPMSK invalid frequency mode: X",
+	},
+	{
+		name: "invalid bit rate mode",
+		raw:  "$GPMSK,318.0,A,100,X,2*50",
+		err:  "nmea: GPMSK invalid bit rate mode: X",
+	},
+	{
+		name: "invalid sentence status",
+		raw:  "$GPMSK,318.0,A,100,M,2,1,X*2C",
+		err:  "nmea: GPMSK invalid sentence status: X",
+	},
+}
+
+func TestMSK(t *testing.T) {
+	for _, tt := range msktests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				msk := m.(MSK)
+				msk.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, msk)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package nmea
+
+const (
+	// TypeMSS type for MSS sentences
+	TypeMSS = "MSS"
+)
+
+// MSS is the signal status of a DGPS beacon (MSK) receiver.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_mss_msk_receiver_signal_status
+type MSS struct {
+	BaseSentence
+	SignalStrength float64 // Signal strength in dB re 1 uV/m
+	SNR            float64 // Signal-to-noise ratio in dB
+	Frequency      float64 // Beacon frequency in kHz
+	BitRate        int64   // Beacon bit rate in bits per second
+	Channel        int64   // Channel number (NMEA 3.0 and later)
+}
+
+// newMSS constructor
+func newMSS(s BaseSentence) (MSS, error) {
+	p := newParser(s)
+	p.AssertType(TypeMSS)
+	p.AssertFieldCount(4, 5)
+	m := MSS{
+		BaseSentence:   s,
+		SignalStrength: p.Float64(0, "signal strength"),
+		SNR:            p.Float64(1, "SNR"),
+		Frequency:      p.Float64(2, "frequency"),
+		BitRate:        p.Int64(3, "bit rate"),
+	}
+	if len(m.Fields) > 4 {
+		m.Channel = p.Int64(4, "channel")
+	}
+	return m, p.Err()
+}
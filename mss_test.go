@@ -0,0 +1,58 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var msstests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  MSS
+}{
+	{
+		name: "good sentence",
+		raw:  "$GPMSS,55,27,318.0,100*4A",
+		msg: MSS{
+			SignalStrength: 55,
+			SNR:            27,
+			Frequency:      318.0,
+			BitRate:        100,
+		},
+	},
+	{
+		name: "good sentence with channel",
+		raw:  "$GPMSS,55,27,318.0,100,1*57",
+		msg: MSS{
+			SignalStrength: 55,
+			SNR:            27,
+			Frequency:      318.0,
+			BitRate:        100,
+			Channel:        1,
+		},
+	},
+	{
+		name: "invalid SNR",
+		raw:  "$GPMSS,55,x7,318.0,100,1*1D",
+		err:  "nmea: GPMSS invalid SNR: x7",
+	},
+}
+
+func TestMSS(t *testing.T) {
+	for _, tt := range msstests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				mss := m.(MSS)
+				mss.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, mss)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package nmea
+
+import "fmt"
+
+const (
+	// TypeNRX type for NRX sentences
+	TypeNRX = "NRX"
+	// ValidNRX message status character
+	ValidNRX = "A"
+	// InvalidNRX message status character
+	InvalidNRX = "V"
+)
+
+// NRX is a received NAVTEX message. Messages longer than a sentence are sent as a
+// sequence of sentences where only the first one carries the message details,
+// see NRXAggregator.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_nrx_navtex_received_message
+type NRX struct {
+	BaseSentence
+	NumberOfSentences int64  // Total number of sentences
+	SentenceNumber    int64  // Sentence number
+	MessageID         int64  // Sequential message identifier, 00 - 99
+	MessageCode       string // NAVTEX message code, B1B2B3B4
+	CoverageArea      string // Transmitter coverage area, B1 of the message code
+	SubjectIndicator  string // Subject indicator, B2 of the message code
+	SerialNumber      string // Serial number, B3B4 of the message code
+	FrequencyIndex    int64  // Frequency table index - 0-not received, 1-490 kHz, 2-518 kHz, 3-4209.5 kHz
+	Time              Time   // UTC time of reception
+	Day               int64  // Day of reception, 01 - 31
+	Month             int64  // Month of reception, 01 - 12
+	Year              int64  // Year of reception
+	TotalCharacters   int64  // Total number of characters in the message
+	BadCharacters     int64  // Total number of bad characters
+	Status            string // Status - A-valid, V-invalid
+	Message           string // Message body
+}
+
+// newNRX constructor
+func newNRX(s BaseSentence) (NRX, error) {
+	p := newParser(s)
+	p.AssertType(TypeNRX)
+	m := NRX{
+		BaseSentence:      s,
+		NumberOfSentences: p.Int64(0, "number of sentences"),
+		SentenceNumber:    p.Int64(1, "sentence number"),
+		MessageID:         p.Int64(2, "message ID"),
+		MessageCode:       p.String(3, "message code"),
+		FrequencyIndex:    p.Int64(4, "frequency table index"),
+		Time:              p.Time(5, "time"),
+		Day:               p.Int64(6, "day"),
+		Month:             p.Int64(7, "month"),
+		Year:              p.Int64(8, "year"),
+		TotalCharacters:   p.Int64(9, "total number of characters"),
+		BadCharacters:     p.Int64(10, "total number of bad characters"),
+		Status:            p.EnumString(11, "status", ValidNRX, InvalidNRX),
+		Message:           p.Text(12, "message"),
+	}
+	if len(m.MessageCode) == 4 {
+		m.CoverageArea = m.MessageCode[0:1]
+		m.SubjectIndicator = m.MessageCode[1:2]
+		m.SerialNumber = m.MessageCode[2:4]
+	} else if m.MessageCode != "" {
+		p.SetErr("message code", m.MessageCode)
+	}
+	return m, p.Err()
+}
+
+// ErrorRate returns the ratio of bad characters to the total number of characters.
+func (m NRX) ErrorRate() float64 {
+	if m.TotalCharacters == 0 {
+		return 0
+	}
+	return float64(m.BadCharacters) / float64(m.TotalCharacters)
+}
+
+// NRXAggregator reassembles NAVTEX messages that span multiple NRX sentences.
+type NRXAggregator struct {
+	message NRX
+	seq     sequence
+}
+
+// Add adds the NRX sentence to the message being reassembled. Once the last
+// sentence of the sequence has been added, the first sentence with the
+// complete message body and true are returned.
+// A sentence with sentence number 1 always starts a new message.
+func (a *NRXAggregator) Add(m NRX) (NRX, bool, error) {
+	if m.SentenceNumber == 1 {
+		a.message = m
+		a.message.Message = ""
+	}
+	done, err := a.seq.add(TypeNRX, m.NumberOfSentences, m.SentenceNumber)
+	if err == nil && m.MessageID != a.message.MessageID {
+		err = fmt.Errorf("nmea: NRX sentence %d does not belong to message %d", m.SentenceNumber, a.message.MessageID)
+	}
+	if err != nil {
+		a.Reset()
+		return NRX{}, false, err
+	}
+	a.message.Message += m.Message
+	if !done {
+		return NRX{}, false, nil
+	}
+	message := a.message
+	a.Reset()
+	return message, true, nil
+}
+
+// Reset discards the message being reassembled.
+func (a *NRXAggregator) Reset() {
+	*a = NRXAggregator{}
+}
@@ -0,0 +1,101 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var nrxtests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  NRX
+}{
+	{
+		name: "good sentence",
+		raw:  "$CRNRX,007,001,00,IE69,1,135600,27,06,2001,241,3,A,==========================*09",
+		msg: NRX{
+			NumberOfSentences: 7,
+			SentenceNumber:    1,
+			MessageID:         0,
+			MessageCode:       "IE69",
+			CoverageArea:      "I",
+			SubjectIndicator:  "E",
+			SerialNumber:      "69",
+			FrequencyIndex:    1,
+			Time:              Time{true, 13, 56, 0, 0, 0},
+			Day:               27,
+			Month:             6,
+			Year:              2001,
+			TotalCharacters:   241,
+			BadCharacters:     3,
+			Status:            ValidNRX,
+			Message:           "==========================",
+		},
+	},
+	{
+		name: "good continuation sentence",
+		raw:  "$CRNRX,007,002,00,,,,,,,,,,========^0D^0AISSUED ON SATURDAY 06 JANUARY 2001.*29",
+		msg: NRX{
+			NumberOfSentences: 7,
+			SentenceNumber:    2,
+			Message:           "========\r\nISSUED ON SATURDAY 06 JANUARY 2001.",
+		},
+	},
+	{
+		name: "invalid status",
+		raw:  "$CRNRX,002,001,01,CA05,2,135600,27,06,2001,40,0,X,GALE WARNING*46",
+		err:  "nmea: CRNRX invalid status: X",
+	},
+	{
+		name: "invalid frequency table index",
+		raw:  "$CRNRX,002,001,01,CA05,x,135600,27,06,2001,40,0,A,GALE WARNING*15",
+		err:  "nmea: CRNRX invalid frequency table index: x",
+	},
+}
+
+func TestNRX(t *testing.T) {
+	for _, tt := range nrxtests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				nrx := m.(NRX)
+				nrx.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, nrx)
+			}
+		})
+	}
+}
+
+func TestNRXErrorRate(t *testing.T) {
+	assert.Equal(t, 0.25, NRX{TotalCharacters: 40, BadCharacters: 10}.ErrorRate())
+	assert.Equal(t, 0.0, NRX{}.ErrorRate())
+}
+
+func TestNRXAggregator(t *testing.T) {
+	var a NRXAggregator
+	m, err := Parse("$CRNRX,002,001,01,CA05,2,135600,27,06,2001,40,0,A,GALE WARNING*5F")
+	assert.NoError(t, err)
+	_, done, err := a.Add(m.(NRX))
+	assert.NoError(t, err)
+	assert.False(t, done)
+
+	m, err = Parse("$CRNRX,002,002,01,,,,,,,,,, FORTIES*08")
+	assert.NoError(t, err)
+	nrx, done, err := a.Add(m.(NRX))
+	assert.NoError(t, err)
+	assert.True(t, done)
+	assert.Equal(t, "CA05", nrx.MessageCode)
+	assert.Equal(t, int64(40), nrx.TotalCharacters)
+	assert.Equal(t, "GALE WARNING FORTIES", nrx.Message)
+
+	_, _, err = a.Add(NRX{NumberOfSentences: 2, SentenceNumber: 1, MessageID: 1})
+	assert.NoError(t, err)
+	_, _, err = a.Add(NRX{NumberOfSentences: 2, SentenceNumber: 2, MessageID: 2})
+	assert.EqualError(t, err, "nmea: NRX sentence 2 does not belong to message 1")
+}
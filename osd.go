@@ -0,0 +1,53 @@
+package nmea
+
+const (
+	// TypeOSD type for OSD sentences
+	TypeOSD = "OSD"
+	// ValidOSD heading status character
+	ValidOSD = "A"
+	// InvalidOSD heading status character
+	InvalidOSD = "V"
+	// BottomTrackingOSD reference character, bottom tracking log
+	BottomTrackingOSD = "B"
+	// ManualOSD reference character, manually entered
+	ManualOSD = "M"
+	// WaterOSD reference character, water referenced
+	WaterOSD = "W"
+	// RadarTrackingOSD reference character, radar tracking of fixed target
+	RadarTrackingOSD = "R"
+	// PositioningSystemOSD reference character, positioning system ground reference
+	PositioningSystemOSD = "P"
+)
+
+// OSD is the own ship data, emitted by radar displays.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_osd_own_ship_data
+type OSD struct {
+	BaseSentence
+	Heading         float64 // Heading in degrees true
+	HeadingStatus   string  // Heading status - A-ok, V-invalid
+	VesselCourse    float64 // Vessel course in degrees true
+	CourseReference string  // Course reference - B, M, W, R or P
+	VesselSpeed     float64 // Vessel speed
+	SpeedReference  string  // Speed reference - B, M, W, R or P
+	VesselSet       float64 // Vessel set in degrees true
+	VesselDrift     float64 // Vessel drift (speed)
+	SpeedUnits      string  // Speed units, K, N or S
+}
+
+// newOSD constructor
+func newOSD(s BaseSentence) (OSD, error) {
+	p := newParser(s)
+	p.AssertType(TypeOSD)
+	return OSD{
+		BaseSentence:    s,
+		Heading:         p.Float64(0, "heading"),
+		HeadingStatus:   p.EnumString(1, "heading status", ValidOSD, InvalidOSD),
+		VesselCourse:    p.Float64(2, "vessel course"),
+		CourseReference: p.EnumString(3, "course reference", BottomTrackingOSD, ManualOSD, WaterOSD, RadarTrackingOSD, PositioningSystemOSD),
+		VesselSpeed:     p.Float64(4, "vessel speed"),
+		SpeedReference:  p.EnumString(5, "speed reference", BottomTrackingOSD, ManualOSD, WaterOSD, RadarTrackingOSD, PositioningSystemOSD),
+		VesselSet:       p.Float64(6, "vessel set"),
+		VesselDrift:     p.Float64(7, "vessel drift"),
+		SpeedUnits:      p.EnumString(8, "speed units", DistanceKilometers, DistanceNauticalMiles, DistanceStatuteMiles),
+	}, p.Err()
+}
@@ -0,0 +1,62 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var osdtests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  OSD
+}{
+	{
+		name: "good sentence",
+		raw:  "$RAOSD,35.1,A,36.0,P,10.2,P,15.3,0.1,N*41",
+		msg: OSD{
+			Heading:         35.1,
+			HeadingStatus:   ValidOSD,
+			VesselCourse:    36.0,
+			CourseReference: PositioningSystemOSD,
+			VesselSpeed:     10.2,
+			SpeedReference:  PositioningSystemOSD,
+			VesselSet:       15.3,
+			VesselDrift:     0.1,
+			SpeedUnits:      DistanceNauticalMiles,
+		},
+	},
+	{
+		name: "invalid heading status",
+		raw:  "$RAOSD,35.1,X,36.0,P,10.2,P,15.3,0.1,N*58",
+		err:  "nmea: RAOSD invalid heading status: X",
+	},
+	{
+		name: "invalid course reference",
+		raw:  "$RAOSD,35.1,A,36.0,X,10.2,P,15.3,0.1,N*49",
+		err:  "nmea: RAOSD invalid course reference: X",
+	},
+	{
+		name: "invalid speed units",
+		raw:  "$RAOSD,35.1,A,36.0,P,10.2,P,15.3,0.1,X*57",
+		err:  "nmea: RAOSD invalid speed units: X",
+	},
+}
+
+func TestOSD(t *testing.T) {
+	for _, tt := range osdtests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				osd := m.(OSD)
+				osd.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, osd)
+			}
+		})
+	}
+}
@@ -1,8 +1,11 @@
 package nmea
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 )
 
 // parser provides a simple way of accessing and parsing
@@ -24,6 +27,17 @@ func (p *parser) AssertType(typ string) {
 	}
 }
 
+// AssertFieldCount makes sure the number of fields is one of the provided counts,
+// one for each layout of the sentence in the NMEA versions that added trailing fields.
+func (p *parser) AssertFieldCount(counts ...int) {
+	for _, n := range counts {
+		if len(p.Fields) == n {
+			return
+		}
+	}
+	p.SetErr("number of fields", strconv.Itoa(len(p.Fields)))
+}
+
 // Err returns the first error encountered during the parser's usage.
 func (p *parser) Err() error {
 	return p.err
@@ -33,10 +47,31 @@ func (p *parser) Err() error {
 // effect if there is already an error.
 func (p *parser) SetErr(context, value string) {
 	if p.err == nil {
-		p.err = fmt.Errorf("nmea: %s invalid %s: %s", p.Prefix(), context, value)
+		p.err = &FieldError{Prefix: p.Prefix(), Context: context, Value: value}
 	}
 }
 
+// ErrInvalidField is matched by errors.Is for all FieldError values.
+var ErrInvalidField = errors.New("nmea: invalid field")
+
+// FieldError is the error for a sentence field that could not be parsed.
+// The message is only formatted when Error is called.
+type FieldError struct {
+	Prefix  string // Talker and type of the sentence (e.g GPRMC)
+	Context string // Description of the field
+	Value   string // Offending value
+}
+
+// Error formats the error message.
+func (e *FieldError) Error() string {
+	return "nmea: " + e.Prefix + " invalid " + e.Context + ": " + e.Value
+}
+
+// Is reports whether the target is ErrInvalidField.
+func (e *FieldError) Is(target error) bool {
+	return target == ErrInvalidField
+}
+
 // String returns the field value at the specified index.
 func (p *parser) String(i int, context string) string {
 	if p.err != nil {
@@ -49,6 +84,11 @@ func (p *parser) String(i int, context string) string {
 	return p.Fields[i]
 }
 
+// HasValue reports whether the field at the specified index is present and not empty.
+func (p *parser) HasValue(i int) bool {
+	return i >= 0 && i < len(p.Fields) && p.Fields[i] != ""
+}
+
 // ListString returns a list of all fields from the given start index.
 // An error occurs if there is no fields after the given start index.
 func (p *parser) ListString(from int, context string) (list []string) {
@@ -62,7 +102,38 @@ func (p *parser) ListString(from int, context string) (list []string) {
 	return append(list, p.Fields[from:]...)
 }
 
+// ListStringView returns the fields from the given start index without copying them.
+// The result aliases the sentence Fields, so writing to it modifies them as well.
+// An error occurs if there is no fields after the given start index.
+func (p *parser) ListStringView(from int, context string) []string {
+	if p.err != nil {
+		return []string{}
+	}
+	if from < 0 || from >= len(p.Fields) {
+		p.SetErr(context, "index out of range")
+		return []string{}
+	}
+	return p.Fields[from:len(p.Fields):len(p.Fields)]
+}
+
+// Text returns the text of all fields from the given start index, with
+// the ^HH hex encoded reserved characters decoded. Commas should be sent
+// as ^2C but not every talker escapes them, so the fields are joined back.
+// An error occurs if there is no fields after the given start index.
+func (p *parser) Text(from int, context string) string {
+	s := strings.Join(p.ListStringView(from, context), FieldSep)
+	if p.err != nil {
+		return ""
+	}
+	v, err := unescapeText(s)
+	if err != nil {
+		p.SetErr(context, s)
+	}
+	return v
+}
+
 // EnumString returns the field value at the specified index.
+// The matching option is returned rather than the field, so the value is the option constant.
 // An error occurs if the value is not one of the options and not empty.
 func (p *parser) EnumString(i int, context string, options ...string) string {
 	s := p.String(i, context)
@@ -71,7 +142,7 @@ func (p *parser) EnumString(i int, context string, options ...string) string {
 	}
 	for _, o := range options {
 		if o == s {
-			return s
+			return o
 		}
 	}
 	p.SetErr(context, s)
@@ -121,6 +192,23 @@ func (p *parser) Int64(i int, context string) int64 {
 	return v
 }
 
+// HexInt64 returns the int64 value of the hexadecimal field at the specified index.
+// If the value is an empty string, 0 is returned.
+func (p *parser) HexInt64(i int, context string) int64 {
+	s := p.String(i, context)
+	if p.err != nil {
+		return 0
+	}
+	if s == "" {
+		return 0
+	}
+	v, err := strconv.ParseInt(s, 16, 64)
+	if err != nil {
+		p.SetErr(context, s)
+	}
+	return v
+}
+
 // Float64 returns the float64 value at the specified index.
 // If the value is an empty string, 0 is returned.
 func (p *parser) Float64(i int, context string) float64 {
@@ -152,6 +240,20 @@ func (p *parser) Time(i int, context string) Time {
 	return v
 }
 
+// Duration returns the time.Duration value at the specified index.
+// If the value is empty, 0 is returned.
+func (p *parser) Duration(i int, context string) time.Duration {
+	s := p.String(i, context)
+	if p.err != nil {
+		return 0
+	}
+	v, err := ParseDuration(s)
+	if err != nil {
+		p.SetErr(context, s)
+	}
+	return v
+}
+
 // Date returns the Date value at the specified index.
 // If the value is empty, the Date is marked as invalid.
 func (p *parser) Date(i int, context string) Date {
@@ -181,31 +283,59 @@ func (p *parser) LatLong(i, j int, context string) float64 {
 	return v
 }
 
+// Latitude returns the latitude value of the coordinate and hemisphere fields at the specified indexes.
+func (p *parser) Latitude(i, j int, context string) Latitude {
+	return Latitude(p.LatLong(i, j, context))
+}
+
+// Longitude returns the longitude value of the coordinate and hemisphere fields at the specified indexes.
+func (p *parser) Longitude(i, j int, context string) Longitude {
+	return Longitude(p.LatLong(i, j, context))
+}
+
 // SixBitASCIIArmour decodes the 6-bit ascii armor used for VDM and VDO messages
 func (p *parser) SixBitASCIIArmour(i int, fillBits int, context string) []byte {
+	result := p.SixBitASCIIArmourAppend([]byte{}, i, fillBits, context)
 	if p.err != nil {
 		return nil
 	}
+	return result
+}
+
+// SixBitASCIIArmourAppend decodes the 6-bit ascii armor like SixBitASCIIArmour, but
+// appends the bits to dst and returns the extended buffer, so the memory can be reused.
+// On error dst is returned unchanged.
+func (p *parser) SixBitASCIIArmourAppend(dst []byte, i int, fillBits int, context string) []byte {
+	if p.err != nil {
+		return dst
+	}
 	if fillBits < 0 || fillBits >= 6 {
 		p.SetErr(context, "fill bits")
-		return nil
+		return dst
 	}
 
-	payload := []byte(p.String(i, "encoded payload"))
+	payload := p.String(i, "encoded payload")
 	numBits := len(payload)*6 - fillBits
 
 	if numBits < 0 {
 		p.SetErr(context, "num bits")
-		return nil
+		return dst
 	}
 
-	result := make([]byte, numBits)
+	n := len(dst)
+	if cap(dst)-n < numBits {
+		grown := make([]byte, n, n+numBits)
+		copy(grown, dst)
+		dst = grown
+	}
+	result := dst[n : n+numBits]
 	resultIndex := 0
 
-	for _, v := range payload {
+	for j := 0; j < len(payload); j++ {
+		v := payload[j]
 		if v < 48 || v >= 120 {
 			p.SetErr(context, "data byte")
-			return nil
+			return dst[:n]
 		}
 
 		d := v - 48
@@ -219,5 +349,51 @@ func (p *parser) SixBitASCIIArmour(i int, fillBits int, context string) []byte {
 		}
 	}
 
-	return result
+	return dst[:n+numBits]
+}
+
+// sixBitASCIIArmour encodes the payload bits into the 6-bit ascii armor used
+// for VDM, VDO, ABM and BBM messages. It returns the encoded payload and the
+// number of fill bits added to complete the last character.
+func sixBitASCIIArmour(payload []byte) (string, int) {
+	fillBits := (6 - len(payload)%6) % 6
+	data := make([]byte, 0, (len(payload)+fillBits)/6)
+	for i := 0; i < len(payload); i += 6 {
+		var d byte
+		for j := i; j < i+6; j++ {
+			d <<= 1
+			if j < len(payload) {
+				d |= payload[j] & 1
+			}
+		}
+		if d >= 40 {
+			d += 8
+		}
+		data = append(data, d+48)
+	}
+	return string(data), fillBits
+}
+
+// unescapeText decodes the ^HH hex encoded reserved characters of a text field.
+func unescapeText(s string) (string, error) {
+	if !strings.Contains(s, TextEscape) {
+		return s, nil
+	}
+	b := make([]byte, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		if s[i] != TextEscape[0] {
+			b = append(b, s[i])
+			continue
+		}
+		if i+3 > len(s) {
+			return "", strconv.ErrSyntax
+		}
+		v, err := strconv.ParseUint(s[i+1:i+3], 16, 8)
+		if err != nil {
+			return "", err
+		}
+		b = append(b, byte(v))
+		i += 2
+	}
+	return string(b), nil
 }
@@ -1,7 +1,9 @@
 package nmea
 
 import (
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -22,6 +24,23 @@ var parsertests = []struct {
 			return nil
 		},
 	},
+	{
+		name:   "Field count",
+		fields: []string{"foo", "bar"},
+		parse: func(p *parser) interface{} {
+			p.AssertFieldCount(1, 2)
+			return nil
+		},
+	},
+	{
+		name:   "Bad field count",
+		fields: []string{"foo", "bar"},
+		hasErr: true,
+		parse: func(p *parser) interface{} {
+			p.AssertFieldCount(3, 5)
+			return nil
+		},
+	},
 	{
 		name:     "String",
 		fields:   []string{"foo", "bar"},
@@ -56,6 +75,31 @@ var parsertests = []struct {
 			return p.ListString(10, "thing")
 		},
 	},
+	{
+		name:     "HasValue",
+		fields:   []string{"wot", ""},
+		expected: []bool{true, false, false},
+		parse: func(p *parser) interface{} {
+			return []bool{p.HasValue(0), p.HasValue(1), p.HasValue(2)}
+		},
+	},
+	{
+		name:     "ListStringView",
+		fields:   []string{"wot", "foo", "bar"},
+		expected: []string{"foo", "bar"},
+		parse: func(p *parser) interface{} {
+			return p.ListStringView(1, "thing")
+		},
+	},
+	{
+		name:     "ListStringView out of range",
+		fields:   []string{"wot"},
+		expected: []string{},
+		hasErr:   true,
+		parse: func(p *parser) interface{} {
+			return p.ListStringView(10, "thing")
+		},
+	},
 	{
 		name:     "String with existing error",
 		expected: "",
@@ -65,6 +109,32 @@ var parsertests = []struct {
 			return p.String(123, "blah")
 		},
 	},
+	{
+		name:     "Text",
+		fields:   []string{"wot", "foo^2C", "bar^5E"},
+		expected: "foo,,bar^",
+		parse: func(p *parser) interface{} {
+			return p.Text(1, "thing")
+		},
+	},
+	{
+		name:     "Text invalid escape",
+		fields:   []string{"wot", "foo^XX"},
+		expected: "",
+		hasErr:   true,
+		parse: func(p *parser) interface{} {
+			return p.Text(1, "thing")
+		},
+	},
+	{
+		name:     "Text out of range",
+		fields:   []string{"wot"},
+		expected: "",
+		hasErr:   true,
+		parse: func(p *parser) interface{} {
+			return p.Text(10, "thing")
+		},
+	},
 	{
 		name:     "EnumString",
 		fields:   []string{"a", "b", "c"},
@@ -154,6 +224,41 @@ var parsertests = []struct {
 			return p.Int64(0, "context")
 		},
 	},
+	{
+		name:     "HexInt64",
+		fields:   []string{"a10c9f"},
+		expected: int64(0xa10c9f),
+		parse: func(p *parser) interface{} {
+			return p.HexInt64(0, "context")
+		},
+	},
+	{
+		name:     "HexInt64 empty field is zero",
+		fields:   []string{""},
+		expected: int64(0),
+		parse: func(p *parser) interface{} {
+			return p.HexInt64(0, "context")
+		},
+	},
+	{
+		name:     "HexInt64 invalid",
+		fields:   []string{"xyz"},
+		expected: int64(0),
+		hasErr:   true,
+		parse: func(p *parser) interface{} {
+			return p.HexInt64(0, "context")
+		},
+	},
+	{
+		name:     "HexInt64 with existing error",
+		fields:   []string{"a1"},
+		expected: int64(0),
+		hasErr:   true,
+		parse: func(p *parser) interface{} {
+			p.SetErr("context", "value")
+			return p.HexInt64(0, "context")
+		},
+	},
 	{
 		name:     "Float64",
 		fields:   []string{"123.123"},
@@ -192,7 +297,7 @@ var parsertests = []struct {
 	{
 		name:     "Time",
 		fields:   []string{"123456"},
-		expected: Time{true, 12, 34, 56, 0},
+		expected: Time{true, 12, 34, 56, 0, 0},
 		parse: func(p *parser) interface{} {
 			return p.Time(0, "context")
 		},
@@ -224,6 +329,31 @@ var parsertests = []struct {
 			return p.Time(0, "context")
 		},
 	},
+	{
+		name:     "Duration",
+		fields:   []string{"012345.6"},
+		expected: time.Hour + 23*time.Minute + 45*time.Second + 600*time.Millisecond,
+		parse: func(p *parser) interface{} {
+			return p.Duration(0, "context")
+		},
+	},
+	{
+		name:     "Duration empty field is zero",
+		fields:   []string{""},
+		expected: time.Duration(0),
+		parse: func(p *parser) interface{} {
+			return p.Duration(0, "context")
+		},
+	},
+	{
+		name:     "Duration invalid",
+		fields:   []string{"wrong"},
+		expected: time.Duration(0),
+		hasErr:   true,
+		parse: func(p *parser) interface{} {
+			return p.Duration(0, "context")
+		},
+	},
 	{
 		name:     "Date",
 		fields:   []string{"010203"},
@@ -278,3 +408,48 @@ func TestParser(t *testing.T) {
 		})
 	}
 }
+
+func TestParserFieldError(t *testing.T) {
+	_, err := Parse("$GPRMC,220516,D,5133.82,N,00042.24,W,173.8,231.8,130694,004.2,W*75")
+	assert.EqualError(t, err, "nmea: GPRMC invalid validity: D")
+	assert.True(t, errors.Is(err, ErrInvalidField))
+	var fieldErr *FieldError
+	assert.True(t, errors.As(err, &fieldErr))
+	assert.Equal(t, &FieldError{Prefix: "GPRMC", Context: "validity", Value: "D"}, fieldErr)
+
+	_, err = Parse("$GPRMC,220516,A,5133.82,N,00042.24,W,173.8,231.8,130694,004.2,W*70")
+	assert.False(t, errors.Is(err, ErrInvalidField))
+}
+
+func TestSixBitASCIIArmour(t *testing.T) {
+	var payload []byte
+	for d := 0; d < 64; d++ {
+		for i := 5; i >= 0; i-- {
+			payload = append(payload, byte(d>>uint(i))&1)
+		}
+	}
+	payload = append(payload, 1, 0, 1)
+	data, fillBits := sixBitASCIIArmour(payload)
+	assert.Equal(t, "0123456789:;<=>?@ABCDEFGHIJKLMNOPQRSTUVW`abcdefghijklmnopqrstuvw`", data)
+	assert.Equal(t, 3, fillBits)
+
+	p := newParser(BaseSentence{Fields: []string{data}})
+	assert.Equal(t, payload, p.SixBitASCIIArmour(0, fillBits, "payload"))
+	assert.NoError(t, p.Err())
+}
+
+func TestSixBitASCIIArmourAppend(t *testing.T) {
+	p := newParser(BaseSentence{Fields: []string{"1P", "0"}})
+	buf := make([]byte, 1, 64)
+	buf = p.SixBitASCIIArmourAppend(buf, 0, 2, "payload")
+	assert.NoError(t, p.Err())
+	assert.Equal(t, []byte{0, 0, 0, 0, 0, 0, 1, 1, 0, 0, 0}, buf)
+	assert.Equal(t, 64, cap(buf))
+
+	buf = p.SixBitASCIIArmourAppend(buf[:0], 1, 0, "payload")
+	assert.Equal(t, []byte{0, 0, 0, 0, 0, 0}, buf)
+
+	buf = p.SixBitASCIIArmourAppend(buf, 1, 6, "payload")
+	assert.EqualError(t, p.Err(), "nmea:  invalid payload: fill bits")
+	assert.Equal(t, []byte{0, 0, 0, 0, 0, 0}, buf)
+}
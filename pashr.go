@@ -0,0 +1,50 @@
+package nmea
+
+const (
+	// TypePASHR type for PASHR sentences
+	TypePASHR = "ASHR"
+)
+
+// PASHR is the attitude and heave data of an inertial navigation system (proprietary sentence)
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_pashr_rt300_proprietary_roll_and_pitch_sentence
+type PASHR struct {
+	BaseSentence
+	Time            Time    // UTC time
+	Heading         float64 // True heading in degrees
+	Roll            float64 // Roll in degrees
+	Pitch           float64 // Pitch in degrees
+	Heave           float64 // Heave in meters
+	RollAccuracy    float64 // Roll accuracy estimate (standard deviation) in degrees
+	PitchAccuracy   float64 // Pitch accuracy estimate (standard deviation) in degrees
+	HeadingAccuracy float64 // Heading accuracy estimate (standard deviation) in degrees
+	GPSQuality      int64   // GPS quality - 0-no position, 1-non-RTK fix, 2-RTK fix
+	INSStatus       int64   // INS status - 0-pre-alignment, 1-post-alignment
+}
+
+// newPASHR constructor
+func newPASHR(s BaseSentence) (PASHR, error) {
+	p := newParser(s)
+	p.AssertType(TypePASHR)
+	p.AssertFieldCount(6, 11)
+
+	time := p.Time(0, "time")
+	heading := p.Float64(1, "heading")
+	_ = p.EnumString(2, "heading unit", BearingTrue)
+
+	m := PASHR{
+		BaseSentence: s,
+		Time:         time,
+		Heading:      heading,
+		Roll:         p.Float64(3, "roll"),
+		Pitch:        p.Float64(4, "pitch"),
+		Heave:        p.Float64(5, "heave"),
+	}
+	if len(m.Fields) > 6 {
+		m.RollAccuracy = p.Float64(6, "roll accuracy")
+		m.PitchAccuracy = p.Float64(7, "pitch accuracy")
+		m.HeadingAccuracy = p.Float64(8, "heading accuracy")
+		m.GPSQuality = p.Int64(9, "GPS quality")
+		m.INSStatus = p.Int64(10, "INS status")
+	}
+	return m, p.Err()
+}
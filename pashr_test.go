@@ -0,0 +1,73 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var pashrtests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  PASHR
+}{
+	{
+		name: "good sentence",
+		raw:  "$PASHR,085335.000,224.19,T,-01.26,+00.83,+00.00,0.101,0.113,0.267,1,0*06",
+		msg: PASHR{
+			Time:            Time{true, 8, 53, 35, 0, 0},
+			Heading:         224.19,
+			Roll:            -1.26,
+			Pitch:           0.83,
+			Heave:           0,
+			RollAccuracy:    0.101,
+			PitchAccuracy:   0.113,
+			HeadingAccuracy: 0.267,
+			GPSQuality:      1,
+			INSStatus:       0,
+		},
+	},
+	{
+		name: "good sentence without accuracy estimates",
+		raw:  "$PASHR,085335.000,224.19,T,-01.26,+00.83,+00.00*05",
+		msg: PASHR{
+			Time:    Time{true, 8, 53, 35, 0, 0},
+			Heading: 224.19,
+			Roll:    -1.26,
+			Pitch:   0.83,
+		},
+	},
+	{
+		name: "invalid heading unit",
+		raw:  "$PASHR,085335.000,224.19,M,-01.26,+00.83,+00.00,0.101,0.113,0.267,1,0*1F",
+		err:  "nmea: PASHR invalid heading unit: M",
+	},
+	{
+		name: "invalid heave",
+		raw:  "$PASHR,085335.000,224.19,T,-01.26,+00.83,x,0.101,0.113,0.267,1,0*7B",
+		err:  "nmea: PASHR invalid heave: x",
+	},
+	{
+		name: "truncated accuracy fields",
+		raw:  "$PASHR,085335.000,224.19,T,-01.26,+00.83,+00.00,0.101*07",
+		err:  "nmea: PASHR invalid number of fields: 7",
+	},
+}
+
+func TestPASHR(t *testing.T) {
+	for _, tt := range pashrtests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				pashr := m.(PASHR)
+				pashr.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, pashr)
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package nmea
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math"
+)
+
+const (
+	// TypePCDIN type for PCDIN sentences
+	TypePCDIN = "CDIN"
+	// PGNHeading vessel heading PGN
+	PGNHeading = 127250
+	// PGNDepth water depth PGN
+	PGNDepth = 128267
+	// PGNPosition position, rapid update PGN
+	PGNPosition = 129025
+)
+
+// NMEA 2000 "data not available" values of the field types.
+const (
+	naUint8  = 0xFF
+	naUint16 = 0xFFFF
+	naInt16  = 0x7FFF
+	naUint32 = 0xFFFFFFFF
+	naInt32  = 0x7FFFFFFF
+)
+
+// PCDIN is an NMEA 2000 message encapsulated in hex (SeaSmart proprietary sentence)
+type PCDIN struct {
+	BaseSentence
+	PGN       int64  // NMEA 2000 parameter group number
+	Timestamp int64  // Gateway timestamp
+	Source    int64  // NMEA 2000 source address
+	Data      []byte // PGN data
+}
+
+// newPCDIN constructor
+func newPCDIN(s BaseSentence) (PCDIN, error) {
+	p := newParser(s)
+	p.AssertType(TypePCDIN)
+	m := PCDIN{
+		BaseSentence: s,
+		PGN:          p.HexInt64(0, "PGN"),
+		Timestamp:    p.HexInt64(1, "timestamp"),
+		Source:       p.HexInt64(2, "source"),
+	}
+	if data := p.String(3, "data"); p.Err() == nil {
+		b, err := hex.DecodeString(data)
+		if err != nil {
+			p.SetErr("data", data)
+		}
+		m.Data = b
+	}
+	return m, p.Err()
+}
+
+// PCDINHeading is the decoded vessel heading PGN 127250.
+// A value the sender reports as not available is zero and not marked valid.
+type PCDINHeading struct {
+	Heading        float64 // Heading in degrees
+	HeadingValid   bool    // Heading is available
+	Deviation      float64 // Magnetic deviation in degrees
+	DeviationValid bool    // Magnetic deviation is available
+	Variation      float64 // Magnetic variation in degrees
+	VariationValid bool    // Magnetic variation is available
+	Reference      string  // Heading reference - T-true, M-magnetic
+}
+
+// Heading decodes the data of a vessel heading PGN 127250 message.
+func (m PCDIN) Heading() (PCDINHeading, error) {
+	if err := m.check(PGNHeading, 8); err != nil {
+		return PCDINHeading{}, err
+	}
+	heading := binary.LittleEndian.Uint16(m.Data[1:])
+	deviation := int16(binary.LittleEndian.Uint16(m.Data[3:]))
+	variation := int16(binary.LittleEndian.Uint16(m.Data[5:]))
+	h := PCDINHeading{
+		HeadingValid:   heading != naUint16,
+		DeviationValid: deviation != naInt16,
+		VariationValid: variation != naInt16,
+		Reference:      BearingTrue,
+	}
+	if h.HeadingValid {
+		h.Heading = degrees(int64(heading), 0.0001)
+	}
+	if h.DeviationValid {
+		h.Deviation = degrees(int64(deviation), 0.0001)
+	}
+	if h.VariationValid {
+		h.Variation = degrees(int64(variation), 0.0001)
+	}
+	if m.Data[7]&0x03 == 1 {
+		h.Reference = BearingMagnetic
+	}
+	return h, nil
+}
+
+// TrueHeading returns the heading relative to true north, applying the deviation
+// and variation to a magnetic heading. A deviation or variation that is not available
+// is not applied. It reports false if the heading is not available.
+func (h PCDINHeading) TrueHeading() (float64, bool) {
+	if !h.HeadingValid {
+		return 0, false
+	}
+	if h.Reference == BearingTrue {
+		return h.Heading, true
+	}
+	return TrueFromMagnetic(h.Heading+h.Deviation, h.Variation), true
+}
+
+// PCDINDepth is the decoded water depth PGN 128267.
+// A value the sender reports as not available is zero and not marked valid.
+type PCDINDepth struct {
+	Depth       float64 // Depth below the transducer in meters
+	DepthValid  bool    // Depth is available
+	Offset      float64 // Offset from the transducer in meters, positive to the water line, negative to the keel
+	OffsetValid bool    // Offset is available
+	Range       float64 // Maximum range scale in meters
+	RangeValid  bool    // Maximum range scale is available
+}
+
+// Depth decodes the data of a water depth PGN 128267 message.
+func (m PCDIN) Depth() (PCDINDepth, error) {
+	if err := m.check(PGNDepth, 8); err != nil {
+		return PCDINDepth{}, err
+	}
+	depth := binary.LittleEndian.Uint32(m.Data[1:])
+	offset := int16(binary.LittleEndian.Uint16(m.Data[5:]))
+	d := PCDINDepth{
+		DepthValid:  depth != naUint32,
+		OffsetValid: offset != naInt16,
+		RangeValid:  m.Data[7] != naUint8,
+	}
+	if d.DepthValid {
+		d.Depth = float64(depth) * 0.01
+	}
+	if d.OffsetValid {
+		d.Offset = float64(offset) * 0.001
+	}
+	if d.RangeValid {
+		d.Range = float64(m.Data[7]) * 10
+	}
+	return d, nil
+}
+
+// PCDINPosition is the decoded position, rapid update PGN 129025.
+// A coordinate the sender reports as not available is zero and not marked valid.
+type PCDINPosition struct {
+	Latitude       Latitude  // Latitude
+	LatitudeValid  bool      // Latitude is available
+	Longitude      Longitude // Longitude
+	LongitudeValid bool      // Longitude is available
+}
+
+// Position decodes the data of a position, rapid update PGN 129025 message.
+func (m PCDIN) Position() (PCDINPosition, error) {
+	if err := m.check(PGNPosition, 8); err != nil {
+		return PCDINPosition{}, err
+	}
+	latitude := int32(binary.LittleEndian.Uint32(m.Data[0:]))
+	longitude := int32(binary.LittleEndian.Uint32(m.Data[4:]))
+	pos := PCDINPosition{
+		LatitudeValid:  latitude != naInt32,
+		LongitudeValid: longitude != naInt32,
+	}
+	if pos.LatitudeValid {
+		pos.Latitude = Latitude(float64(latitude) * 1e-7)
+	}
+	if pos.LongitudeValid {
+		pos.Longitude = Longitude(float64(longitude) * 1e-7)
+	}
+	return pos, nil
+}
+
+// check verifies the message carries the given PGN with at least size bytes of data.
+func (m PCDIN) check(pgn int64, size int) error {
+	if m.PGN != pgn {
+		return fmt.Errorf("nmea: PCDIN unexpected PGN: %d", m.PGN)
+	}
+	if len(m.Data) < size {
+		return fmt.Errorf("nmea: PCDIN PGN %d data too short: %d bytes", m.PGN, len(m.Data))
+	}
+	return nil
+}
+
+// degrees converts the raw value in the given resolution of radians to degrees.
+func degrees(v int64, resolution float64) float64 {
+	return float64(v) * resolution * 180 / math.Pi
+}
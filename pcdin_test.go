@@ -0,0 +1,119 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var pcdintests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  PCDIN
+}{
+	{
+		name: "good sentence",
+		raw:  "$PCDIN,01F112,000C72EA,09,28C36A0000B40AFD*56",
+		msg: PCDIN{
+			PGN:       127250,
+			Timestamp: 0x000C72EA,
+			Source:    9,
+			Data:      []byte{0x28, 0xC3, 0x6A, 0x00, 0x00, 0xB4, 0x0A, 0xFD},
+		},
+	},
+	{
+		name: "invalid data",
+		raw:  "$PCDIN,01F112,000C72EA,09,28C36A00ZZB40AFD*56",
+		err:  "nmea: PCDIN invalid data: 28C36A00ZZB40AFD",
+	},
+}
+
+func TestPCDIN(t *testing.T) {
+	for _, tt := range pcdintests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				pcdin := m.(PCDIN)
+				pcdin.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, pcdin)
+			}
+		})
+	}
+}
+
+func TestPCDINDecode(t *testing.T) {
+	m, err := Parse("$PCDIN,01F112,000C72EA,09,28C36A0000B40AFD*56")
+	assert.NoError(t, err)
+	heading, err := m.(PCDIN).Heading()
+	assert.NoError(t, err)
+	assert.InDelta(t, 156.595, heading.Heading, 0.001)
+	assert.InDelta(t, 0, heading.Deviation, 0.001)
+	assert.InDelta(t, 15.699, heading.Variation, 0.001)
+	assert.Equal(t, BearingMagnetic, heading.Reference)
+	assert.True(t, heading.HeadingValid && heading.DeviationValid && heading.VariationValid)
+	trueHeading, ok := heading.TrueHeading()
+	assert.True(t, ok)
+	assert.InDelta(t, 172.294, trueHeading, 0.001)
+	_, err = m.(PCDIN).Depth()
+	assert.EqualError(t, err, "nmea: PCDIN unexpected PGN: 127250")
+
+	m, err = Parse("$PCDIN,01F50B,000C72EB,23,01D20400000CFE05*26")
+	assert.NoError(t, err)
+	depth, err := m.(PCDIN).Depth()
+	assert.NoError(t, err)
+	assert.InDelta(t, 12.34, depth.Depth, 0.0001)
+	assert.InDelta(t, -0.5, depth.Offset, 0.0001)
+	assert.InDelta(t, 50, depth.Range, 0.0001)
+	assert.True(t, depth.DepthValid && depth.OffsetValid && depth.RangeValid)
+
+	m, err = Parse("$PCDIN,01F801,000C72EC,02,0778161C4F80C6FA*29")
+	assert.NoError(t, err)
+	position, err := m.(PCDIN).Position()
+	assert.NoError(t, err)
+	assert.InDelta(t, 47.1234567, position.Latitude.Decimal(), 1e-7)
+	assert.InDelta(t, -8.7654321, position.Longitude.Decimal(), 1e-7)
+	assert.True(t, position.LatitudeValid && position.LongitudeValid)
+
+	m, err = Parse("$PCDIN,01F112,000C72EA,09,28C3*24")
+	assert.NoError(t, err)
+	_, err = m.(PCDIN).Heading()
+	assert.EqualError(t, err, "nmea: PCDIN PGN 127250 data too short: 2 bytes")
+}
+
+func TestPCDINDecodeNotAvailable(t *testing.T) {
+	m, err := Parse("$PCDIN,01F112,000C72EA,09,28C36AFF7FB40AFD*27")
+	assert.NoError(t, err)
+	heading, err := m.(PCDIN).Heading()
+	assert.NoError(t, err)
+	assert.True(t, heading.HeadingValid)
+	assert.False(t, heading.DeviationValid)
+	assert.Equal(t, 0.0, heading.Deviation)
+	trueHeading, ok := heading.TrueHeading()
+	assert.True(t, ok)
+	assert.InDelta(t, 172.294, trueHeading, 0.001)
+
+	m, err = Parse("$PCDIN,01F112,000C72EA,09,28FFFFFF7FFF7FFD*56")
+	assert.NoError(t, err)
+	heading, err = m.(PCDIN).Heading()
+	assert.NoError(t, err)
+	assert.Equal(t, PCDINHeading{Reference: BearingMagnetic}, heading)
+	_, ok = heading.TrueHeading()
+	assert.False(t, ok)
+
+	m, err = Parse("$PCDIN,01F50B,000C72EB,23,01FFFFFFFFFF7FFF*50")
+	assert.NoError(t, err)
+	depth, err := m.(PCDIN).Depth()
+	assert.NoError(t, err)
+	assert.Equal(t, PCDINDepth{}, depth)
+
+	m, err = Parse("$PCDIN,01F801,000C72EC,02,FFFFFF7FFFFFFF7F*5C")
+	assert.NoError(t, err)
+	position, err := m.(PCDIN).Position()
+	assert.NoError(t, err)
+	assert.Equal(t, PCDINPosition{}, position)
+}
@@ -0,0 +1,30 @@
+package nmea
+
+import "fmt"
+
+const (
+	// TypePFEC type for PFEC sentences
+	TypePFEC = "FEC"
+	// AttitudePFEC sentence formatter of the PFEC,GPatt attitude message
+	AttitudePFEC = "GPatt"
+	// HeavePFEC sentence formatter of the PFEC,GPhve heave message
+	HeavePFEC = "GPhve"
+)
+
+// newPFEC constructor, the Furuno proprietary messages all share the PFEC
+// type and are told apart by the sentence formatter in the first field.
+func newPFEC(s BaseSentence) (Sentence, error) {
+	p := newParser(s)
+	p.AssertType(TypePFEC)
+	formatter := p.String(0, "sentence formatter")
+	if err := p.Err(); err != nil {
+		return nil, err
+	}
+	switch formatter {
+	case AttitudePFEC:
+		return newPFECGPatt(s)
+	case HeavePFEC:
+		return newPFECGPhve(s)
+	}
+	return nil, fmt.Errorf("nmea: PFEC message '%s' not supported", formatter)
+}
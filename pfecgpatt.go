@@ -0,0 +1,21 @@
+package nmea
+
+// PFECGPatt is the attitude of a satellite compass (Furuno proprietary sentence PFEC,GPatt)
+type PFECGPatt struct {
+	BaseSentence
+	Yaw   float64 // Yaw (heading) in degrees
+	Pitch float64 // Pitch in degrees, positive bow up
+	Roll  float64 // Roll in degrees, positive starboard down
+}
+
+// newPFECGPatt constructor
+func newPFECGPatt(s BaseSentence) (PFECGPatt, error) {
+	p := newParser(s)
+	p.AssertType(TypePFEC)
+	return PFECGPatt{
+		BaseSentence: s,
+		Yaw:          p.Float64(1, "yaw"),
+		Pitch:        p.Float64(2, "pitch"),
+		Roll:         p.Float64(3, "roll"),
+	}, p.Err()
+}
@@ -0,0 +1,51 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var pfecgpatttests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  PFECGPatt
+}{
+	{
+		name: "good sentence",
+		raw:  "$PFEC,GPatt,287.4,-4.3,+1.2*43",
+		msg: PFECGPatt{
+			Yaw:   287.4,
+			Pitch: -4.3,
+			Roll:  1.2,
+		},
+	},
+	{
+		name: "invalid pitch",
+		raw:  "$PFEC,GPatt,287.4,x,+1.2*3F",
+		err:  "nmea: PFEC invalid pitch: x",
+	},
+	{
+		name: "unsupported message",
+		raw:  "$PFEC,GPxyz,1*4D",
+		err:  "nmea: PFEC message 'GPxyz' not supported",
+	},
+}
+
+func TestPFECGPatt(t *testing.T) {
+	for _, tt := range pfecgpatttests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				pfec := m.(PFECGPatt)
+				pfec.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, pfec)
+			}
+		})
+	}
+}
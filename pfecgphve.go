@@ -0,0 +1,26 @@
+package nmea
+
+const (
+	// ValidPFEC heave status character
+	ValidPFEC = "A"
+	// InvalidPFEC heave status character
+	InvalidPFEC = "V"
+)
+
+// PFECGPhve is the heave of a satellite compass (Furuno proprietary sentence PFEC,GPhve)
+type PFECGPhve struct {
+	BaseSentence
+	Heave  float64 // Heave in meters
+	Status string  // Status - A-valid, V-invalid
+}
+
+// newPFECGPhve constructor
+func newPFECGPhve(s BaseSentence) (PFECGPhve, error) {
+	p := newParser(s)
+	p.AssertType(TypePFEC)
+	return PFECGPhve{
+		BaseSentence: s,
+		Heave:        p.Float64(1, "heave"),
+		Status:       p.EnumString(2, "status", ValidPFEC, InvalidPFEC),
+	}, p.Err()
+}
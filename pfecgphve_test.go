@@ -0,0 +1,45 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var pfecgphvetests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  PFECGPhve
+}{
+	{
+		name: "good sentence",
+		raw:  "$PFEC,GPhve,-0.12,A*21",
+		msg: PFECGPhve{
+			Heave:  -0.12,
+			Status: ValidPFEC,
+		},
+	},
+	{
+		name: "invalid status",
+		raw:  "$PFEC,GPhve,-0.12,X*38",
+		err:  "nmea: PFEC invalid status: X",
+	},
+}
+
+func TestPFECGPhve(t *testing.T) {
+	for _, tt := range pfecgphvetests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				pfec := m.(PFECGPhve)
+				pfec.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, pfec)
+			}
+		})
+	}
+}
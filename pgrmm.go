@@ -0,0 +1,30 @@
+package nmea
+
+const (
+	// TypePGRMM type for PGRMM sentences
+	TypePGRMM = "GRMM"
+	// DatumWGS84PGRMM map datum name of WGS84
+	DatumWGS84PGRMM = "WGS 84"
+)
+
+// PGRMM is the currently active map datum (Garmin proprietary sentence)
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_pgrmm_garmin_map_datum
+type PGRMM struct {
+	BaseSentence
+	Datum string // Name of the currently active map datum
+}
+
+// newPGRMM constructor
+func newPGRMM(s BaseSentence) (PGRMM, error) {
+	p := newParser(s)
+	p.AssertType(TypePGRMM)
+	return PGRMM{
+		BaseSentence: s,
+		Datum:        p.String(0, "datum"),
+	}, p.Err()
+}
+
+// IsWGS84 reports whether the active map datum is WGS84.
+func (m PGRMM) IsWGS84() bool {
+	return m.Datum == DatumWGS84PGRMM
+}
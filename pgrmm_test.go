@@ -0,0 +1,56 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var pgrmmtests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  PGRMM
+}{
+	{
+		name: "good sentence",
+		raw:  "$PGRMM,WGS 84*06",
+		msg: PGRMM{
+			Datum: "WGS 84",
+		},
+	},
+	{
+		name: "good sentence with other datum",
+		raw:  "$PGRMM,NAD27 Canada*2F",
+		msg: PGRMM{
+			Datum: "NAD27 Canada",
+		},
+	},
+	{
+		name: "missing datum",
+		raw:  "$PGRMM*45",
+		err:  "nmea: PGRMM invalid datum: index out of range",
+	},
+}
+
+func TestPGRMM(t *testing.T) {
+	for _, tt := range pgrmmtests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				pgrmm := m.(PGRMM)
+				pgrmm.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, pgrmm)
+			}
+		})
+	}
+}
+
+func TestPGRMMIsWGS84(t *testing.T) {
+	assert.True(t, PGRMM{Datum: "WGS 84"}.IsWGS84())
+	assert.False(t, PGRMM{Datum: "NAD27 Canada"}.IsWGS84())
+}
@@ -0,0 +1,49 @@
+package nmea
+
+const (
+	// TypePGRMT type for PGRMT sentences
+	TypePGRMT = "GRMT"
+	// PassPGRMT test result character
+	PassPGRMT = "P"
+	// FailPGRMT test result character
+	FailPGRMT = "F"
+	// RetainedPGRMT data retention character
+	RetainedPGRMT = "R"
+	// LostPGRMT data retention character
+	LostPGRMT = "L"
+	// CollectingPGRMT data collection character
+	CollectingPGRMT = "C"
+)
+
+// PGRMT is the sensor status information (Garmin proprietary sentence)
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_pgrmt_garmin_sensor_status_information
+type PGRMT struct {
+	BaseSentence
+	Product            string      // Product, model and software version
+	ROMChecksum        string      // ROM checksum test - P-pass, F-fail
+	ReceiverFailure    string      // Receiver failure discrete - P-pass, F-fail
+	StoredData         string      // Stored data - R-retained, L-lost
+	RealTimeClock      string      // Real time clock - R-retained, L-lost
+	OscillatorDrift    string      // Oscillator drift discrete - P-pass, F-excessive drift detected
+	DataCollection     string      // Data collection discrete - C-collecting, empty if not collecting
+	BoardTemperature   Temperature // Board temperature
+	BoardConfiguration string      // Board configuration data - R-retained, L-lost
+}
+
+// newPGRMT constructor
+func newPGRMT(s BaseSentence) (PGRMT, error) {
+	p := newParser(s)
+	p.AssertType(TypePGRMT)
+	return PGRMT{
+		BaseSentence:       s,
+		Product:            p.String(0, "product"),
+		ROMChecksum:        p.EnumString(1, "ROM checksum test", PassPGRMT, FailPGRMT),
+		ReceiverFailure:    p.EnumString(2, "receiver failure discrete", PassPGRMT, FailPGRMT),
+		StoredData:         p.EnumString(3, "stored data", RetainedPGRMT, LostPGRMT),
+		RealTimeClock:      p.EnumString(4, "real time clock", RetainedPGRMT, LostPGRMT),
+		OscillatorDrift:    p.EnumString(5, "oscillator drift discrete", PassPGRMT, FailPGRMT),
+		DataCollection:     p.EnumString(6, "data collection discrete", CollectingPGRMT),
+		BoardTemperature:   TemperatureFromCelsius(p.Float64(7, "board temperature")),
+		BoardConfiguration: p.EnumString(8, "board configuration data", RetainedPGRMT, LostPGRMT),
+	}, p.Err()
+}
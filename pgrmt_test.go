@@ -0,0 +1,70 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var pgrmttests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  PGRMT
+}{
+	{
+		name: "good sentence",
+		raw:  "$PGRMT,GPS25-LVS VER 2.50,P,P,R,R,P,C,31,R*4C",
+		msg: PGRMT{
+			Product:            "GPS25-LVS VER 2.50",
+			ROMChecksum:        PassPGRMT,
+			ReceiverFailure:    PassPGRMT,
+			StoredData:         RetainedPGRMT,
+			RealTimeClock:      RetainedPGRMT,
+			OscillatorDrift:    PassPGRMT,
+			DataCollection:     CollectingPGRMT,
+			BoardTemperature:   31,
+			BoardConfiguration: RetainedPGRMT,
+		},
+	},
+	{
+		name: "good sentence with failures",
+		raw:  "$PGRMT,GPS25-LVS VER 2.50,P,F,R,L,P,,31,*55",
+		msg: PGRMT{
+			Product:          "GPS25-LVS VER 2.50",
+			ROMChecksum:      PassPGRMT,
+			ReceiverFailure:  FailPGRMT,
+			StoredData:       RetainedPGRMT,
+			RealTimeClock:    LostPGRMT,
+			OscillatorDrift:  PassPGRMT,
+			BoardTemperature: 31,
+		},
+	},
+	{
+		name: "invalid ROM checksum test",
+		raw:  "$PGRMT,GPS25-LVS VER 2.50,X,P,R,R,P,C,31,R*44",
+		err:  "nmea: PGRMT invalid ROM checksum test: X",
+	},
+	{
+		name: "invalid board temperature",
+		raw:  "$PGRMT,GPS25-LVS VER 2.50,P,P,R,R,P,C,x,R*36",
+		err:  "nmea: PGRMT invalid board temperature: x",
+	},
+}
+
+func TestPGRMT(t *testing.T) {
+	for _, tt := range pgrmttests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				pgrmt := m.(PGRMT)
+				pgrmt.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, pgrmt)
+			}
+		})
+	}
+}
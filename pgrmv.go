@@ -0,0 +1,27 @@
+package nmea
+
+const (
+	// TypePGRMV type for PGRMV sentences
+	TypePGRMV = "GRMV"
+)
+
+// PGRMV is the 3D velocity information (Garmin proprietary sentence)
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_pgrmv_garmin_3d_velocity_information
+type PGRMV struct {
+	BaseSentence
+	East  float64 // True east velocity in meters per second
+	North float64 // True north velocity in meters per second
+	Up    float64 // Up velocity in meters per second
+}
+
+// newPGRMV constructor
+func newPGRMV(s BaseSentence) (PGRMV, error) {
+	p := newParser(s)
+	p.AssertType(TypePGRMV)
+	return PGRMV{
+		BaseSentence: s,
+		East:         p.Float64(0, "east velocity"),
+		North:        p.Float64(1, "north velocity"),
+		Up:           p.Float64(2, "up velocity"),
+	}, p.Err()
+}
@@ -0,0 +1,46 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var pgrmvtests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  PGRMV
+}{
+	{
+		name: "good sentence",
+		raw:  "$PGRMV,32.4,-1.5,0.8*48",
+		msg: PGRMV{
+			East:  32.4,
+			North: -1.5,
+			Up:    0.8,
+		},
+	},
+	{
+		name: "invalid north velocity",
+		raw:  "$PGRMV,32.4,x,0.8*37",
+		err:  "nmea: PGRMV invalid north velocity: x",
+	},
+}
+
+func TestPGRMV(t *testing.T) {
+	for _, tt := range pgrmvtests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				pgrmv := m.(PGRMV)
+				pgrmv.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, pgrmv)
+			}
+		})
+	}
+}
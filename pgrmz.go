@@ -0,0 +1,35 @@
+package nmea
+
+const (
+	// TypePGRMZ type for PGRMZ sentences
+	TypePGRMZ = "GRMZ"
+	// FeetPGRMZ altitude unit character
+	FeetPGRMZ = "f"
+	// MetersPGRMZ altitude unit character
+	MetersPGRMZ = "M"
+	// Fix2DPGRMZ position fix dimension, 2D fix (user altitude)
+	Fix2DPGRMZ = "2"
+	// Fix3DPGRMZ position fix dimension, 3D fix (GPS altitude)
+	Fix3DPGRMZ = "3"
+)
+
+// PGRMZ is the altitude information (Garmin proprietary sentence)
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_pgrmz_garmin_altitude_information
+type PGRMZ struct {
+	BaseSentence
+	Altitude     float64 // Altitude
+	Unit         string  // Altitude unit - f-feet, M-meters
+	FixDimension string  // Position fix dimension - 2-user altitude, 3-GPS altitude
+}
+
+// newPGRMZ constructor
+func newPGRMZ(s BaseSentence) (PGRMZ, error) {
+	p := newParser(s)
+	p.AssertType(TypePGRMZ)
+	return PGRMZ{
+		BaseSentence: s,
+		Altitude:     p.Float64(0, "altitude"),
+		Unit:         p.EnumString(1, "altitude unit", FeetPGRMZ, MetersPGRMZ),
+		FixDimension: p.EnumString(2, "fix dimension", Fix2DPGRMZ, Fix3DPGRMZ),
+	}, p.Err()
+}
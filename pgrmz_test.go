@@ -0,0 +1,60 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var pgrmztests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  PGRMZ
+}{
+	{
+		name: "good sentence",
+		raw:  "$PGRMZ,246,f,3*1B",
+		msg: PGRMZ{
+			Altitude:     246,
+			Unit:         FeetPGRMZ,
+			FixDimension: Fix3DPGRMZ,
+		},
+	},
+	{
+		name: "good sentence in meters",
+		raw:  "$PGRMZ,93,M,2*0B",
+		msg: PGRMZ{
+			Altitude:     93,
+			Unit:         MetersPGRMZ,
+			FixDimension: Fix2DPGRMZ,
+		},
+	},
+	{
+		name: "invalid altitude unit",
+		raw:  "$PGRMZ,246,x,3*05",
+		err:  "nmea: PGRMZ invalid altitude unit: x",
+	},
+	{
+		name: "invalid fix dimension",
+		raw:  "$PGRMZ,246,f,5*1D",
+		err:  "nmea: PGRMZ invalid fix dimension: 5",
+	},
+}
+
+func TestPGRMZ(t *testing.T) {
+	for _, tt := range pgrmztests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				pgrmz := m.(PGRMZ)
+				pgrmz.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, pgrmz)
+			}
+		})
+	}
+}
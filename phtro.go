@@ -0,0 +1,40 @@
+package nmea
+
+const (
+	// TypePHTRO type for PHTRO sentences
+	TypePHTRO = "HTRO"
+	// BowUpPHTRO pitch direction character
+	BowUpPHTRO = "M"
+	// BowDownPHTRO pitch direction character
+	BowDownPHTRO = "P"
+	// PortUpPHTRO roll direction character
+	PortUpPHTRO = "B"
+	// StarboardUpPHTRO roll direction character
+	StarboardUpPHTRO = "T"
+)
+
+// PHTRO is the pitch and roll of a motion sensor (proprietary sentence)
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_phtro_vessel_pitch_and_roll
+type PHTRO struct {
+	BaseSentence
+	Pitch float64 // Pitch in degrees, positive bow up
+	Roll  float64 // Roll in degrees, positive port up
+}
+
+// newPHTRO constructor
+func newPHTRO(s BaseSentence) (PHTRO, error) {
+	p := newParser(s)
+	p.AssertType(TypePHTRO)
+	m := PHTRO{
+		BaseSentence: s,
+		Pitch:        p.Float64(0, "pitch"),
+		Roll:         p.Float64(2, "roll"),
+	}
+	if p.EnumString(1, "pitch direction", BowUpPHTRO, BowDownPHTRO) == BowDownPHTRO {
+		m.Pitch = 0 - m.Pitch
+	}
+	if p.EnumString(3, "roll direction", PortUpPHTRO, StarboardUpPHTRO) == StarboardUpPHTRO {
+		m.Roll = 0 - m.Roll
+	}
+	return m, p.Err()
+}
@@ -0,0 +1,53 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var phtrotests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  PHTRO
+}{
+	{
+		name: "good sentence bow down starboard up",
+		raw:  "$PHTRO,10.37,P,177.62,T*65",
+		msg: PHTRO{
+			Pitch: -10.37,
+			Roll:  -177.62,
+		},
+	},
+	{
+		name: "good sentence bow up port up",
+		raw:  "$PHTRO,1.50,M,2.00,B*58",
+		msg: PHTRO{
+			Pitch: 1.5,
+			Roll:  2,
+		},
+	},
+	{
+		name: "invalid pitch direction",
+		raw:  "$PHTRO,10.37,X,177.62,T*6D",
+		err:  "nmea: PHTRO invalid pitch direction: X",
+	},
+}
+
+func TestPHTRO(t *testing.T) {
+	for _, tt := range phtrotests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				phtro := m.(PHTRO)
+				phtro.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, phtro)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package nmea
+
+const (
+	// TypePKWDWPL type for PKWDWPL sentences
+	TypePKWDWPL = "KWDWPL"
+	// ValidPKWDWPL character
+	ValidPKWDWPL = "A"
+	// InvalidPKWDWPL character
+	InvalidPKWDWPL = "V"
+)
+
+// PKWDWPL is an APRS waypoint station report (Kenwood proprietary sentence)
+// https://www.aprs.org/aprs11/waypoints.txt
+type PKWDWPL struct {
+	BaseSentence
+	Time        Time      // Time Stamp
+	Validity    string    // validity - A-ok, V-invalid
+	Latitude    Latitude  // Latitude
+	Longitude   Longitude // Longitude
+	Speed       float64   // Speed in knots
+	Course      float64   // True course
+	Date        Date      // Date
+	Altitude    float64   // Altitude in meters
+	Name        string    // Waypoint name, usually the station callsign
+	SymbolTable string    // APRS symbol table identifier
+	Symbol      string    // APRS symbol code
+}
+
+// newPKWDWPL constructor
+func newPKWDWPL(s BaseSentence) (PKWDWPL, error) {
+	p := newParser(s)
+	p.AssertType(TypePKWDWPL)
+	m := PKWDWPL{
+		BaseSentence: s,
+		Time:         p.Time(0, "time"),
+		Validity:     p.EnumString(1, "validity", ValidPKWDWPL, InvalidPKWDWPL),
+		Latitude:     p.Latitude(2, 3, "latitude"),
+		Longitude:    p.Longitude(4, 5, "longitude"),
+		Speed:        p.Float64(6, "speed"),
+		Course:       p.Float64(7, "course"),
+		Date:         p.Date(8, "date"),
+		Altitude:     p.Float64(9, "altitude"),
+		Name:         p.String(10, "name"),
+	}
+	if symbol := p.String(11, "symbol"); symbol != "" {
+		if len(symbol) != 2 {
+			p.SetErr("symbol", symbol)
+		} else {
+			m.SymbolTable, m.Symbol = symbol[:1], symbol[1:]
+		}
+	}
+	return m, p.Err()
+}
+
+// Position returns the reported position.
+func (m PKWDWPL) Position() Position {
+	return Position{Latitude: m.Latitude, Longitude: m.Longitude}
+}
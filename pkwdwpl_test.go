@@ -0,0 +1,73 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var pkwdwpltests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  PKWDWPL
+}{
+	{
+		name: "good sentence",
+		raw:  "$PKWDWPL,150803,A,4237.14,N,07120.83,W,,,190316,,AC7FD-1,/-*09",
+		msg: PKWDWPL{
+			Time:        Time{true, 15, 8, 3, 0, 0},
+			Validity:    ValidPKWDWPL,
+			Latitude:    Latitude(MustParseGPS("4237.14 N")),
+			Longitude:   Longitude(MustParseGPS("07120.83 W")),
+			Date:        Date{true, 19, 3, 16},
+			Name:        "AC7FD-1",
+			SymbolTable: "/",
+			Symbol:      "-",
+		},
+	},
+	{
+		name: "good sentence with speed, course and altitude",
+		raw:  "$PKWDWPL,053125,V,4531.7900,N,12253.4800,W,12.5,275.0,220512,35.2,WA7ABC-9,/>*74",
+		msg: PKWDWPL{
+			Time:        Time{true, 5, 31, 25, 0, 0},
+			Validity:    InvalidPKWDWPL,
+			Latitude:    Latitude(MustParseGPS("4531.7900 N")),
+			Longitude:   Longitude(MustParseGPS("12253.4800 W")),
+			Speed:       12.5,
+			Course:      275,
+			Date:        Date{true, 22, 5, 12},
+			Altitude:    35.2,
+			Name:        "WA7ABC-9",
+			SymbolTable: "/",
+			Symbol:      ">",
+		},
+	},
+	{
+		name: "invalid validity",
+		raw:  "$PKWDWPL,150803,X,4237.14,N,07120.83,W,,,190316,,AC7FD-1,/-*10",
+		err:  "nmea: PKWDWPL invalid validity: X",
+	},
+	{
+		name: "invalid symbol",
+		raw:  "$PKWDWPL,150803,A,4237.14,N,07120.83,W,,,190316,,AC7FD-1,/-X*51",
+		err:  "nmea: PKWDWPL invalid symbol: /-X",
+	},
+}
+
+func TestPKWDWPL(t *testing.T) {
+	for _, tt := range pkwdwpltests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				pkwdwpl := m.(PKWDWPL)
+				pkwdwpl.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, pkwdwpl)
+			}
+		})
+	}
+}
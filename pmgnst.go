@@ -0,0 +1,39 @@
+package nmea
+
+const (
+	// TypePMGNST type for PMGNST sentences
+	TypePMGNST = "MGNST"
+	// TrackingPMGNST tracking status character, a fix is being tracked
+	TrackingPMGNST = "T"
+	// SearchingPMGNST tracking status character, searching for satellites
+	SearchingPMGNST = "F"
+)
+
+// PMGNST is the receiver status (Magellan proprietary sentence)
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_pmgnst_magellan_status
+type PMGNST struct {
+	BaseSentence
+	Firmware              string  // Firmware version number
+	FixDimension          int64   // Fix dimension - 1-no fix, 2-2D fix, 3-3D fix
+	Tracking              string  // Tracking status - T-tracking, F-searching
+	Bias                  int64   // Receiver clock bias
+	TimeLeft              float64 // Time left to operation on the battery in hours
+	FrequencyCompensation int64   // Oscillator frequency compensation
+	Satellite             int64   // PRN of the satellite in tracking focus
+}
+
+// newPMGNST constructor
+func newPMGNST(s BaseSentence) (PMGNST, error) {
+	p := newParser(s)
+	p.AssertType(TypePMGNST)
+	return PMGNST{
+		BaseSentence:          s,
+		Firmware:              p.String(0, "firmware version"),
+		FixDimension:          p.Int64(1, "fix dimension"),
+		Tracking:              p.EnumString(2, "tracking status", TrackingPMGNST, SearchingPMGNST),
+		Bias:                  p.Int64(3, "bias"),
+		TimeLeft:              p.Float64(4, "time left"),
+		FrequencyCompensation: p.Int64(5, "frequency compensation"),
+		Satellite:             p.Int64(6, "satellite in tracking"),
+	}, p.Err()
+}
@@ -0,0 +1,55 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var pmgnsttests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  PMGNST
+}{
+	{
+		name: "good sentence",
+		raw:  "$PMGNST,02.12,3,T,534,05.0,+03327,00*40",
+		msg: PMGNST{
+			Firmware:              "02.12",
+			FixDimension:          3,
+			Tracking:              TrackingPMGNST,
+			Bias:                  534,
+			TimeLeft:              5,
+			FrequencyCompensation: 3327,
+			Satellite:             0,
+		},
+	},
+	{
+		name: "invalid tracking status",
+		raw:  "$PMGNST,02.12,3,X,534,05.0,+03327,00*4C",
+		err:  "nmea: PMGNST invalid tracking status: X",
+	},
+	{
+		name: "invalid fix dimension",
+		raw:  "$PMGNST,02.12,x,T,534,05.0,+03327,00*0B",
+		err:  "nmea: PMGNST invalid fix dimension: x",
+	},
+}
+
+func TestPMGNST(t *testing.T) {
+	for _, tt := range pmgnsttests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				pmgnst := m.(PMGNST)
+				pmgnst.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, pmgnst)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const (
+	// TypePMTK001 type for PMTK001 acknowledgement sentences
+	TypePMTK001 = "MTK001"
+	// TypePMTK010 type for PMTK010 system message sentences
+	TypePMTK010 = "MTK010"
+	// InvalidCommandPMTK acknowledgement flag, invalid command or packet
+	InvalidCommandPMTK = 0
+	// UnsupportedCommandPMTK acknowledgement flag, unsupported command or packet type
+	UnsupportedCommandPMTK = 1
+	// FailedCommandPMTK acknowledgement flag, valid command but the action failed
+	FailedCommandPMTK = 2
+	// SucceededCommandPMTK acknowledgement flag, valid command and the action succeeded
+	SucceededCommandPMTK = 3
+)
+
+// PMTK001 is the acknowledgement of a PMTK command (MediaTek proprietary sentence)
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_pmtk001_mediatek_acknowledgement
+type PMTK001 struct {
+	BaseSentence
+	Command int64 // Command or packet type being acknowledged
+	Flag    int64 // Acknowledgement flag - 0-invalid, 1-unsupported, 2-failed, 3-succeeded
+}
+
+// newPMTK001 constructor
+func newPMTK001(s BaseSentence) (PMTK001, error) {
+	p := newParser(s)
+	p.AssertType(TypePMTK001)
+	return PMTK001{
+		BaseSentence: s,
+		Command:      p.Int64(0, "command"),
+		Flag:         p.Int64(1, "flag"),
+	}, p.Err()
+}
+
+// PMTK010 is a system message (MediaTek proprietary sentence)
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_pmtk010_mediatek_system_message
+type PMTK010 struct {
+	BaseSentence
+	Message int64 // System message - 0-unknown, 1-startup, 2-EPO notification, 3-normal mode
+}
+
+// newPMTK010 constructor
+func newPMTK010(s BaseSentence) (PMTK010, error) {
+	p := newParser(s)
+	p.AssertType(TypePMTK010)
+	return PMTK010{
+		BaseSentence: s,
+		Message:      p.Int64(0, "system message"),
+	}, p.Err()
+}
+
+// pmtkOutputFields maps the sentence types to their field index in the
+// PMTK314 set NMEA output command.
+var pmtkOutputFields = map[string]int{
+	TypeGLL: 0,
+	TypeRMC: 1,
+	TypeVTG: 2,
+	TypeGGA: 3,
+	TypeGSA: 4,
+	TypeGSV: 5,
+	TypeGRS: 6,
+	TypeGST: 7,
+	TypeZDA: 17,
+}
+
+// PMTKSetUpdateRate returns the PMTK220 command setting the position fix interval.
+func PMTKSetUpdateRate(interval time.Duration) string {
+	ms := int64(interval / time.Millisecond)
+	return formatSentence(SentenceStart, "PMTK220", []string{strconv.FormatInt(ms, 10)})
+}
+
+// PMTKSetBaudRate returns the PMTK251 command setting the serial port baud rate.
+func PMTKSetBaudRate(baud int) string {
+	return formatSentence(SentenceStart, "PMTK251", []string{strconv.Itoa(baud)})
+}
+
+// PMTKSetOutput returns the PMTK314 command setting the sentence output mask.
+// The rates map the sentence types (e.g. TypeRMC) to the number of position
+// fixes between outputs, sentences which are not in rates are disabled.
+func PMTKSetOutput(rates map[string]int) (string, error) {
+	fields := make([]string, 19)
+	for i := range fields {
+		fields[i] = "0"
+	}
+	for typ, rate := range rates {
+		i, ok := pmtkOutputFields[typ]
+		if !ok {
+			return "", fmt.Errorf("nmea: PMTK314 sentence output not supported: %s", typ)
+		}
+		fields[i] = strconv.Itoa(rate)
+	}
+	return formatSentence(SentenceStart, "PMTK314", fields), nil
+}
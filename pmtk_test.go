@@ -0,0 +1,72 @@
+package nmea
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var pmtktests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  Sentence
+}{
+	{
+		name: "good acknowledgement",
+		raw:  "$PMTK001,604,3*32",
+		msg: PMTK001{
+			Command: 604,
+			Flag:    SucceededCommandPMTK,
+		},
+	},
+	{
+		name: "invalid acknowledgement flag",
+		raw:  "$PMTK001,604,x*79",
+		err:  "nmea: PMTK001 invalid flag: x",
+	},
+	{
+		name: "good system message",
+		raw:  "$PMTK010,001*2E",
+		msg: PMTK010{
+			Message: 1,
+		},
+	},
+}
+
+func TestPMTK(t *testing.T) {
+	for _, tt := range pmtktests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				switch pmtk := m.(type) {
+				case PMTK001:
+					pmtk.BaseSentence = BaseSentence{}
+					assert.Equal(t, tt.msg, pmtk)
+				case PMTK010:
+					pmtk.BaseSentence = BaseSentence{}
+					assert.Equal(t, tt.msg, pmtk)
+				default:
+					t.Fatalf("unexpected sentence %T", m)
+				}
+			}
+		})
+	}
+}
+
+func TestPMTKCommands(t *testing.T) {
+	assert.Equal(t, "$PMTK220,200*2C", PMTKSetUpdateRate(200*time.Millisecond))
+	assert.Equal(t, "$PMTK251,38400*27", PMTKSetBaudRate(38400))
+
+	cmd, err := PMTKSetOutput(map[string]int{TypeRMC: 1, TypeGGA: 1, TypeGSV: 5, TypeZDA: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, "$PMTK314,0,1,0,1,0,5,0,0,0,0,0,0,0,0,0,0,0,1,0*2C", cmd)
+
+	_, err = PMTKSetOutput(map[string]int{TypeTXT: 1})
+	assert.EqualError(t, err, "nmea: PMTK314 sentence output not supported: TXT")
+}
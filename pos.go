@@ -0,0 +1,50 @@
+package nmea
+
+const (
+	// TypePOS type for POS sentences
+	TypePOS = "POS"
+	// ValidPOS validity flag character
+	ValidPOS = "A"
+	// InvalidPOS validity flag character
+	InvalidPOS = "V"
+	// StatusReportPOS sentence status flag character, the sentence is a status report
+	StatusReportPOS = "R"
+	// StatusConfigurationPOS sentence status flag character, the sentence is a configuration command
+	StatusConfigurationPOS = "C"
+)
+
+// POS is the device position and ship dimensions report or configuration command.
+// The device position is given in ship coordinates relative to the ship's reference point.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_pos_device_position_and_ship_dimensions
+type POS struct {
+	BaseSentence
+	EquipmentID     string  // Equipment identification, talker ID of the device
+	EquipmentNumber int64   // Equipment number, 00 - 99
+	PositionValid   string  // Position validity flag - A-valid, V-invalid
+	X               float64 // Position x-coordinate (meters)
+	Y               float64 // Position y-coordinate (meters)
+	Z               float64 // Position z-coordinate (meters)
+	DimensionsValid string  // Ship width and length validity flag - A-valid, V-invalid
+	ShipWidth       float64 // Ship width (meters)
+	ShipLength      float64 // Ship length (meters)
+	SentenceStatus  string  // Sentence status flag - R-status report, C-configuration command
+}
+
+// newPOS constructor
+func newPOS(s BaseSentence) (POS, error) {
+	p := newParser(s)
+	p.AssertType(TypePOS)
+	return POS{
+		BaseSentence:    s,
+		EquipmentID:     p.String(0, "equipment identification"),
+		EquipmentNumber: p.Int64(1, "equipment number"),
+		PositionValid:   p.EnumString(2, "position validity flag", ValidPOS, InvalidPOS),
+		X:               p.Float64(3, "x-coordinate"),
+		Y:               p.Float64(4, "y-coordinate"),
+		Z:               p.Float64(5, "z-coordinate"),
+		DimensionsValid: p.EnumString(6, "ship dimensions validity flag", ValidPOS, InvalidPOS),
+		ShipWidth:       p.Float64(7, "ship width"),
+		ShipLength:      p.Float64(8, "ship length"),
+		SentenceStatus:  p.EnumString(9, "sentence status", StatusReportPOS, StatusConfigurationPOS),
+	}, p.Err()
+}
@@ -0,0 +1,58 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var postests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  POS
+}{
+	{
+		name: "good sentence",
+		raw:  "$IIPOS,GP,01,A,-5.0,12.5,20.0,A,32.0,180.0,R*32",
+		msg: POS{
+			EquipmentID:     "GP",
+			EquipmentNumber: 1,
+			PositionValid:   ValidPOS,
+			X:               -5,
+			Y:               12.5,
+			Z:               20,
+			DimensionsValid: ValidPOS,
+			ShipWidth:       32,
+			ShipLength:      180,
+			SentenceStatus:  StatusReportPOS,
+		},
+	},
+	{
+		name: "invalid sentence status",
+		raw:  "$IIPOS,GP,01,A,-5.0,12.5,20.0,A,32.0,180.0,X*38",
+		err:  "nmea: IIPOS invalid sentence status: X",
+	},
+	{
+		name: "invalid y-coordinate",
+		raw:  "$IIPOS,GP,01,V,-5.0,x,20.0,A,32.0,180.0,R*45",
+		err:  "nmea: IIPOS invalid y-coordinate: x",
+	},
+}
+
+func TestPOS(t *testing.T) {
+	for _, tt := range postests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				pos := m.(POS)
+				pos.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, pos)
+			}
+		})
+	}
+}
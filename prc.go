@@ -0,0 +1,48 @@
+package nmea
+
+const (
+	// TypePRC type for PRC sentences
+	TypePRC = "PRC"
+	// ValidPRC status and mode character
+	ValidPRC = "A"
+	// InvalidPRC status and mode character
+	InvalidPRC = "V"
+	// PercentPRC mode character, value in percent
+	PercentPRC = "P"
+	// RevolutionsPRC RPM mode character, value in revolutions per minute
+	RevolutionsPRC = "R"
+	// DegreesPRC pitch mode character, value in degrees
+	DegreesPRC = "D"
+)
+
+// PRC is the propulsion remote control status. The operating location uses
+// the same characters as ETL.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_prc_propulsion_remote_control_status
+type PRC struct {
+	BaseSentence
+	LeverDemand       float64 // Lever demand position, -100 (astern) to 100 (ahead) percent
+	LeverStatus       string  // Lever demand status - A-valid, V-invalid
+	RPMDemand         float64 // RPM demand value
+	RPMMode           string  // RPM mode - P-percent, R-revolutions per minute, V-invalid
+	PitchDemand       float64 // Pitch demand value
+	PitchMode         string  // Pitch mode - P-percent, D-degrees, V-invalid
+	OperatingLocation string  // Operating location - B-bridge, P-port wing, S-starboard wing, C-engine control room, E-engine side, W-wing
+	EngineNumber      int64   // Number of the engine or propeller shaft, 0 - 9
+}
+
+// newPRC constructor
+func newPRC(s BaseSentence) (PRC, error) {
+	p := newParser(s)
+	p.AssertType(TypePRC)
+	return PRC{
+		BaseSentence:      s,
+		LeverDemand:       p.Float64(0, "lever demand"),
+		LeverStatus:       p.EnumString(1, "lever demand status", ValidPRC, InvalidPRC),
+		RPMDemand:         p.Float64(2, "RPM demand"),
+		RPMMode:           p.EnumString(3, "RPM mode", PercentPRC, RevolutionsPRC, InvalidPRC),
+		PitchDemand:       p.Float64(4, "pitch demand"),
+		PitchMode:         p.EnumString(5, "pitch mode", PercentPRC, DegreesPRC, InvalidPRC),
+		OperatingLocation: p.EnumString(6, "operating location", BridgeETL, PortWingETL, StarboardWingETL, EngineControlRoomETL, EngineSideETL, WingETL),
+		EngineNumber:      p.Int64(7, "engine number"),
+	}, p.Err()
+}
@@ -0,0 +1,56 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var prctests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  PRC
+}{
+	{
+		name: "good sentence",
+		raw:  "$ERPRC,45.0,A,950.0,R,12.5,D,B,1*57",
+		msg: PRC{
+			LeverDemand:       45,
+			LeverStatus:       ValidPRC,
+			RPMDemand:         950,
+			RPMMode:           RevolutionsPRC,
+			PitchDemand:       12.5,
+			PitchMode:         DegreesPRC,
+			OperatingLocation: BridgeETL,
+			EngineNumber:      1,
+		},
+	},
+	{
+		name: "invalid RPM mode",
+		raw:  "$ERPRC,45.0,A,950.0,X,12.5,D,B,1*5D",
+		err:  "nmea: ERPRC invalid RPM mode: X",
+	},
+	{
+		name: "invalid lever demand",
+		raw:  "$ERPRC,x,A,950.0,R,12.5,D,B,1*30",
+		err:  "nmea: ERPRC invalid lever demand: x",
+	},
+}
+
+func TestPRC(t *testing.T) {
+	for _, tt := range prctests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				prc := m.(PRC)
+				prc.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, prc)
+			}
+		})
+	}
+}
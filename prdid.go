@@ -0,0 +1,27 @@
+package nmea
+
+const (
+	// TypePRDID type for PRDID sentences
+	TypePRDID = "RDID"
+)
+
+// PRDID is the pitch, roll and heading of a motion reference unit (proprietary sentence)
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_prdid_rdi_pitch_roll_and_heading
+type PRDID struct {
+	BaseSentence
+	Pitch   float64 // Pitch in degrees, positive bow up
+	Roll    float64 // Roll in degrees, positive port up
+	Heading float64 // Heading in degrees
+}
+
+// newPRDID constructor
+func newPRDID(s BaseSentence) (PRDID, error) {
+	p := newParser(s)
+	p.AssertType(TypePRDID)
+	return PRDID{
+		BaseSentence: s,
+		Pitch:        p.Float64(0, "pitch"),
+		Roll:         p.Float64(1, "roll"),
+		Heading:      p.Float64(2, "heading"),
+	}, p.Err()
+}
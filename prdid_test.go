@@ -0,0 +1,46 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var prdidtests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  PRDID
+}{
+	{
+		name: "good sentence",
+		raw:  "$PRDID,-10.37,2.34,230.34*62",
+		msg: PRDID{
+			Pitch:   -10.37,
+			Roll:    2.34,
+			Heading: 230.34,
+		},
+	},
+	{
+		name: "invalid roll",
+		raw:  "$PRDID,-10.37,x,230.34*01",
+		err:  "nmea: PRDID invalid roll: x",
+	},
+}
+
+func TestPRDID(t *testing.T) {
+	for _, tt := range prdidtests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				prdid := m.(PRDID)
+				prdid.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, prdid)
+			}
+		})
+	}
+}
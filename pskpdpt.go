@@ -0,0 +1,33 @@
+package nmea
+
+const (
+	// TypePSKPDPT type for PSKPDPT sentences
+	TypePSKPDPT = "SKPDPT"
+)
+
+// PSKPDPT is the depth of water with the transducer location (Skipper proprietary sentence)
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_pskpdpt_depth_of_water_for_multiple_transducer_installation
+type PSKPDPT struct {
+	BaseSentence
+	Depth              Distance // Water depth relative to the transducer
+	Offset             Distance // Offset from the transducer, positive to the water line, negative to the keel
+	RangeScale         float64  // Maximum range scale in use
+	BottomEchoStrength int64    // Bottom echo strength, 0 - 9
+	ChannelNumber      int64    // Echo sounder channel (transducer) number, 0 - 99
+	TransducerLocation string   // Transducer location
+}
+
+// newPSKPDPT constructor
+func newPSKPDPT(s BaseSentence) (PSKPDPT, error) {
+	p := newParser(s)
+	p.AssertType(TypePSKPDPT)
+	return PSKPDPT{
+		BaseSentence:       s,
+		Depth:              DistanceFromMeters(p.Float64(0, "depth")),
+		Offset:             DistanceFromMeters(p.Float64(1, "offset")),
+		RangeScale:         p.Float64(2, "range scale"),
+		BottomEchoStrength: p.Int64(3, "bottom echo strength"),
+		ChannelNumber:      p.Int64(4, "channel number"),
+		TransducerLocation: p.Text(5, "transducer location"),
+	}, p.Err()
+}
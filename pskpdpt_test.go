@@ -0,0 +1,59 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var pskpdpttests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  PSKPDPT
+}{
+	{
+		name: "good sentence",
+		raw:  "$PSKPDPT,0002.5,-00.5,0020,09,01,BOW*27",
+		msg: PSKPDPT{
+			Depth:              2.5,
+			Offset:             -0.5,
+			RangeScale:         20,
+			BottomEchoStrength: 9,
+			ChannelNumber:      1,
+			TransducerLocation: "BOW",
+		},
+	},
+	{
+		name: "good sentence without transducer location",
+		raw:  "$PSKPDPT,0002.5,+00.0,0010,10,03,*77",
+		msg: PSKPDPT{
+			Depth:              2.5,
+			RangeScale:         10,
+			BottomEchoStrength: 10,
+			ChannelNumber:      3,
+		},
+	},
+	{
+		name: "invalid depth",
+		raw:  "$PSKPDPT,x,-00.5,0020,09,01,BOW*46",
+		err:  "nmea: PSKPDPT invalid depth: x",
+	},
+}
+
+func TestPSKPDPT(t *testing.T) {
+	for _, tt := range pskpdpttests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				pskpdpt := m.(PSKPDPT)
+				pskpdpt.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, pskpdpt)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+const (
+	// TypePSRF150 type for PSRF150 OK to send sentences
+	TypePSRF150 = "SRF150"
+	// TypePSRF161 type for PSRF161 debug message sentences
+	TypePSRF161 = "SRF161"
+	// NMEAProtocolPSRF serial port protocol, NMEA
+	NMEAProtocolPSRF = 1
+	// SiRFProtocolPSRF serial port protocol, SiRF binary
+	SiRFProtocolPSRF = 0
+)
+
+// PSRF150 tells whether the receiver is ready to accept commands (SiRF proprietary sentence)
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_psrf150_sirf_ok_to_send
+type PSRF150 struct {
+	BaseSentence
+	OKToSend bool // Whether the receiver accepts commands
+}
+
+// newPSRF150 constructor
+func newPSRF150(s BaseSentence) (PSRF150, error) {
+	p := newParser(s)
+	p.AssertType(TypePSRF150)
+	return PSRF150{
+		BaseSentence: s,
+		OKToSend:     p.EnumString(0, "ok to send", "0", "1") == "1",
+	}, p.Err()
+}
+
+// PSRF161 is a free text debug message (SiRF proprietary sentence)
+type PSRF161 struct {
+	BaseSentence
+	Message string // Debug message text
+}
+
+// newPSRF161 constructor
+func newPSRF161(s BaseSentence) (PSRF161, error) {
+	p := newParser(s)
+	p.AssertType(TypePSRF161)
+	return PSRF161{
+		BaseSentence: s,
+		Message:      p.Text(0, "message"),
+	}, p.Err()
+}
+
+// psrfMessages maps the sentence types to their message number in the
+// PSRF103 query/rate control command.
+var psrfMessages = map[string]int{
+	TypeGGA: 0,
+	TypeGLL: 1,
+	TypeGSA: 2,
+	TypeGSV: 3,
+	TypeRMC: 4,
+	TypeVTG: 5,
+	TypeMSS: 6,
+	TypeZDA: 8,
+}
+
+// PSRFSetSerialPort returns the PSRF100 command setting the serial port protocol
+// (NMEAProtocolPSRF or SiRFProtocolPSRF), baud rate, data bits, stop bits and
+// parity (0-none, 1-odd, 2-even).
+func PSRFSetSerialPort(protocol, baud, dataBits, stopBits, parity int) string {
+	return formatSentence(SentenceStart, "PSRF100", []string{
+		strconv.Itoa(protocol),
+		strconv.Itoa(baud),
+		strconv.Itoa(dataBits),
+		strconv.Itoa(stopBits),
+		strconv.Itoa(parity),
+	})
+}
+
+// PSRFSetRate returns the PSRF103 command setting the output rate in seconds
+// of the given sentence type, a rate of 0 disables the sentence.
+func PSRFSetRate(typ string, rate int, checksum bool) (string, error) {
+	return psrf103(typ, 0, rate, checksum)
+}
+
+// PSRFQuery returns the PSRF103 command requesting a single output of the
+// given sentence type.
+func PSRFQuery(typ string, checksum bool) (string, error) {
+	return psrf103(typ, 1, 0, checksum)
+}
+
+// psrf103 formats the PSRF103 query/rate control command.
+func psrf103(typ string, mode, rate int, checksum bool) (string, error) {
+	msg, ok := psrfMessages[typ]
+	if !ok {
+		return "", fmt.Errorf("nmea: PSRF103 sentence not supported: %s", typ)
+	}
+	cksum := 0
+	if checksum {
+		cksum = 1
+	}
+	return formatSentence(SentenceStart, "PSRF103", []string{
+		fmt.Sprintf("%02d", msg),
+		fmt.Sprintf("%02d", mode),
+		fmt.Sprintf("%02d", rate),
+		fmt.Sprintf("%02d", cksum),
+	}), nil
+}
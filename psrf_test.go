@@ -0,0 +1,73 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var psrftests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  Sentence
+}{
+	{
+		name: "good ok to send",
+		raw:  "$PSRF150,1*3E",
+		msg: PSRF150{
+			OKToSend: true,
+		},
+	},
+	{
+		name: "invalid ok to send",
+		raw:  "$PSRF150,x*77",
+		err:  "nmea: PSRF150 invalid ok to send: x",
+	},
+	{
+		name: "good debug message",
+		raw:  "$PSRF161,SV 12 lost lock*24",
+		msg: PSRF161{
+			Message: "SV 12 lost lock",
+		},
+	},
+}
+
+func TestPSRF(t *testing.T) {
+	for _, tt := range psrftests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				switch psrf := m.(type) {
+				case PSRF150:
+					psrf.BaseSentence = BaseSentence{}
+					assert.Equal(t, tt.msg, psrf)
+				case PSRF161:
+					psrf.BaseSentence = BaseSentence{}
+					assert.Equal(t, tt.msg, psrf)
+				default:
+					t.Fatalf("unexpected sentence %T", m)
+				}
+			}
+		})
+	}
+}
+
+func TestPSRFCommands(t *testing.T) {
+	assert.Equal(t, "$PSRF100,1,9600,8,1,0*0D", PSRFSetSerialPort(NMEAProtocolPSRF, 9600, 8, 1, 0))
+
+	cmd, err := PSRFQuery(TypeGGA, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "$PSRF103,00,01,00,01*25", cmd)
+
+	cmd, err = PSRFSetRate(TypeRMC, 1, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "$PSRF103,04,00,01,01*21", cmd)
+
+	_, err = PSRFSetRate(TypeTXT, 1, true)
+	assert.EqualError(t, err, "nmea: PSRF103 sentence not supported: TXT")
+}
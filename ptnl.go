@@ -0,0 +1,30 @@
+package nmea
+
+import "fmt"
+
+const (
+	// TypePTNL type for PTNL sentences
+	TypePTNL = "TNL"
+	// PositionPTNL message identifier of the PTNL,GGK time, position, position type and DOP message
+	PositionPTNL = "GGK"
+	// AttitudePTNL message identifier of the PTNL,AVR time, yaw, tilt, range message
+	AttitudePTNL = "AVR"
+)
+
+// newPTNL constructor, the Trimble proprietary messages all share the PTNL
+// type and are told apart by the message identifier in the first field.
+func newPTNL(s BaseSentence) (Sentence, error) {
+	p := newParser(s)
+	p.AssertType(TypePTNL)
+	id := p.String(0, "message ID")
+	if err := p.Err(); err != nil {
+		return nil, err
+	}
+	switch id {
+	case PositionPTNL:
+		return newPTNLGGK(s)
+	case AttitudePTNL:
+		return newPTNLAVR(s)
+	}
+	return nil, fmt.Errorf("nmea: PTNL message '%s' not supported", id)
+}
@@ -0,0 +1,44 @@
+package nmea
+
+// PTNLAVR is the time, yaw, tilt and range of a moving baseline RTK solution
+// (Trimble proprietary sentence PTNL,AVR)
+type PTNLAVR struct {
+	BaseSentence
+	Time          Time    // UTC time of the vector fix
+	Yaw           float64 // Yaw angle in degrees
+	Tilt          float64 // Tilt angle in degrees
+	Roll          float64 // Roll angle in degrees
+	Range         float64 // Range (baseline length) in meters
+	Quality       int64   // GPS quality - 0-invalid, 1-autonomous, 2-RTK float, 3-RTK fix, 4-differential
+	PDOP          float64 // Position dilution of precision
+	NumSatellites int64   // Number of satellites used in the solution
+}
+
+// newPTNLAVR constructor
+func newPTNLAVR(s BaseSentence) (PTNLAVR, error) {
+	p := newParser(s)
+	p.AssertType(TypePTNL)
+
+	time := p.Time(1, "time")
+
+	yaw := p.Float64(2, "yaw")
+	_ = p.EnumString(3, "yaw label", "Yaw")
+
+	tilt := p.Float64(4, "tilt")
+	_ = p.EnumString(5, "tilt label", "Tilt")
+
+	roll := p.Float64(6, "roll")
+	_ = p.EnumString(7, "roll label", "Roll")
+
+	return PTNLAVR{
+		BaseSentence:  s,
+		Time:          time,
+		Yaw:           yaw,
+		Tilt:          tilt,
+		Roll:          roll,
+		Range:         p.Float64(8, "range"),
+		Quality:       p.Int64(9, "quality"),
+		PDOP:          p.Float64(10, "pdop"),
+		NumSatellites: p.Int64(11, "number of satellites"),
+	}, p.Err()
+}
@@ -0,0 +1,50 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var ptnlavrtests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  PTNLAVR
+}{
+	{
+		name: "good sentence",
+		raw:  "$PTNL,AVR,181059.6,+149.4688,Yaw,+0.0134,Tilt,,,60.191,3,2.5,6*00",
+		msg: PTNLAVR{
+			Time:          Time{true, 18, 10, 59, 600, 0},
+			Yaw:           149.4688,
+			Tilt:          0.0134,
+			Range:         60.191,
+			Quality:       3,
+			PDOP:          2.5,
+			NumSatellites: 6,
+		},
+	},
+	{
+		name: "invalid tilt label",
+		raw:  "$PTNL,AVR,181059.6,+149.4688,Yaw,+0.0134,Pitch,,,60.191,3,2.5,6*63",
+		err:  "nmea: PTNL invalid tilt label: Pitch",
+	},
+}
+
+func TestPTNLAVR(t *testing.T) {
+	for _, tt := range ptnlavrtests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				ptnl := m.(PTNLAVR)
+				ptnl.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, ptnl)
+			}
+		})
+	}
+}
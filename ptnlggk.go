@@ -0,0 +1,63 @@
+package nmea
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	// EllipsoidalHeightPTNL prefix of the ellipsoidal height field
+	EllipsoidalHeightPTNL = "EHT"
+)
+
+// PTNLGGK is the time, position, position type and DOP (Trimble proprietary sentence PTNL,GGK)
+type PTNLGGK struct {
+	BaseSentence
+	Time              Time      // UTC time of the position fix
+	Date              Date      // UTC date of the position fix
+	Latitude          Latitude  // Latitude
+	Longitude         Longitude // Longitude
+	Quality           int64     // GPS quality - 0-invalid, 1-autonomous, 2-RTK float, 3-RTK fix, 4-differential, 5-SBAS, 6-RTK float 3D, 7-RTK fix 3D, 8-RTK float 2D, 9-RTK fix 2D, 10-OmniSTAR HP/XP, 11-OmniSTAR VBS, 12-location RTK, 13-beacon DGPS
+	NumSatellites     int64     // Number of satellites in the position fix
+	DOP               float64   // Dilution of precision
+	EllipsoidalHeight float64   // Ellipsoidal height in meters
+}
+
+// newPTNLGGK constructor
+func newPTNLGGK(s BaseSentence) (PTNLGGK, error) {
+	p := newParser(s)
+	p.AssertType(TypePTNL)
+	m := PTNLGGK{
+		BaseSentence: s,
+		Time:         p.Time(1, "time"),
+	}
+	// the date is sent as mmddyy
+	if date := p.String(2, "date"); date != "" && p.Err() == nil {
+		var err error
+		if len(date) == 6 {
+			m.Date, err = ParseDate(date[2:4] + date[0:2] + date[4:6])
+		}
+		if len(date) != 6 || err != nil {
+			p.SetErr("date", date)
+		}
+	}
+	m.Latitude = p.Latitude(3, 4, "latitude")
+	m.Longitude = p.Longitude(5, 6, "longitude")
+	m.Quality = p.Int64(7, "quality")
+	m.NumSatellites = p.Int64(8, "number of satellites")
+	m.DOP = p.Float64(9, "dop")
+	if height := p.String(10, "ellipsoidal height"); height != "" && p.Err() == nil {
+		v, err := strconv.ParseFloat(strings.TrimPrefix(height, EllipsoidalHeightPTNL), 64)
+		if err != nil {
+			p.SetErr("ellipsoidal height", height)
+		}
+		m.EllipsoidalHeight = v
+	}
+	_ = p.EnumString(11, "ellipsoidal height unit", "M")
+	return m, p.Err()
+}
+
+// Position returns the reported position.
+func (m PTNLGGK) Position() Position {
+	return Position{Latitude: m.Latitude, Longitude: m.Longitude}
+}
@@ -0,0 +1,70 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var ptnlggktests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  PTNLGGK
+}{
+	{
+		name: "good sentence",
+		raw:  "$PTNL,GGK,102939.00,051910,5000.97323841,N,00827.62010742,E,5,09,1.9,EHT150.790,M*73",
+		msg: PTNLGGK{
+			Time:              Time{true, 10, 29, 39, 0, 0},
+			Date:              Date{true, 19, 5, 10},
+			Latitude:          Latitude(MustParseGPS("5000.97323841 N")),
+			Longitude:         Longitude(MustParseGPS("00827.62010742 E")),
+			Quality:           5,
+			NumSatellites:     9,
+			DOP:               1.9,
+			EllipsoidalHeight: 150.79,
+		},
+	},
+	{
+		name: "good sentence without height prefix",
+		raw:  "$PTNL,GGK,102939.00,051910,5000.97323841,N,00827.62010742,E,5,09,1.9,150.790,M*2A",
+		msg: PTNLGGK{
+			Time:              Time{true, 10, 29, 39, 0, 0},
+			Date:              Date{true, 19, 5, 10},
+			Latitude:          Latitude(MustParseGPS("5000.97323841 N")),
+			Longitude:         Longitude(MustParseGPS("00827.62010742 E")),
+			Quality:           5,
+			NumSatellites:     9,
+			DOP:               1.9,
+			EllipsoidalHeight: 150.79,
+		},
+	},
+	{
+		name: "invalid date",
+		raw:  "$PTNL,GGK,102939.00,05191x,5000.97323841,N,00827.62010742,E,5,09,1.9,EHT150.790,M*3B",
+		err:  "nmea: PTNL invalid date: 05191x",
+	},
+	{
+		name: "unsupported message",
+		raw:  "$PTNL,XYZ,1*6C",
+		err:  "nmea: PTNL message 'XYZ' not supported",
+	},
+}
+
+func TestPTNLGGK(t *testing.T) {
+	for _, tt := range ptnlggktests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				ptnl := m.(PTNLGGK)
+				ptnl.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, ptnl)
+			}
+		})
+	}
+}
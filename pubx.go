@@ -0,0 +1,34 @@
+package nmea
+
+import "fmt"
+
+const (
+	// TypePUBX type for PUBX sentences
+	TypePUBX = "UBX"
+	// PositionPUBX message identifier of the PUBX,00 position message
+	PositionPUBX = "00"
+	// SatellitesPUBX message identifier of the PUBX,03 satellite status message
+	SatellitesPUBX = "03"
+	// TimePUBX message identifier of the PUBX,04 time of day and clock information message
+	TimePUBX = "04"
+)
+
+// newPUBX constructor, the u-blox proprietary messages all share the PUBX
+// type and are told apart by the message identifier in the first field.
+func newPUBX(s BaseSentence) (Sentence, error) {
+	p := newParser(s)
+	p.AssertType(TypePUBX)
+	id := p.String(0, "message ID")
+	if err := p.Err(); err != nil {
+		return nil, err
+	}
+	switch id {
+	case PositionPUBX:
+		return newPUBX00(s)
+	case SatellitesPUBX:
+		return newPUBX03(s)
+	case TimePUBX:
+		return newPUBX04(s)
+	}
+	return nil, fmt.Errorf("nmea: PUBX message '%s' not supported", id)
+}
@@ -0,0 +1,72 @@
+package nmea
+
+const (
+	// NoFixPUBX navigation status, no fix
+	NoFixPUBX = "NF"
+	// DeadReckoningPUBX navigation status, dead reckoning only solution
+	DeadReckoningPUBX = "DR"
+	// StandAlone2DPUBX navigation status, stand alone 2D solution
+	StandAlone2DPUBX = "G2"
+	// StandAlone3DPUBX navigation status, stand alone 3D solution
+	StandAlone3DPUBX = "G3"
+	// Differential2DPUBX navigation status, differential 2D solution
+	Differential2DPUBX = "D2"
+	// Differential3DPUBX navigation status, differential 3D solution
+	Differential3DPUBX = "D3"
+	// GPSDeadReckoningPUBX navigation status, combined GPS and dead reckoning solution
+	GPSDeadReckoningPUBX = "RK"
+	// TimeOnlyPUBX navigation status, time only solution
+	TimeOnlyPUBX = "TT"
+)
+
+// PUBX00 is the lat/long position data (u-blox proprietary sentence PUBX,00)
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_pubx_00_u_blox_lat_long_position_data
+type PUBX00 struct {
+	BaseSentence
+	Time               Time      // UTC time
+	Latitude           Latitude  // Latitude
+	Longitude          Longitude // Longitude
+	Altitude           float64   // Altitude above user datum ellipsoid in meters
+	NavStatus          string    // Navigation status - NF, DR, G2, G3, D2, D3, RK, TT
+	HorizontalAccuracy float64   // Horizontal accuracy estimate in meters
+	VerticalAccuracy   float64   // Vertical accuracy estimate in meters
+	Speed              float64   // Speed over ground in km/h
+	Course             float64   // Course over ground in degrees
+	VerticalVelocity   float64   // Vertical velocity in meters per second, positive downwards
+	DifferentialAge    float64   // Age of differential corrections in seconds
+	HDOP               float64   // Horizontal dilution of precision
+	VDOP               float64   // Vertical dilution of precision
+	TDOP               float64   // Time dilution of precision
+	NumSatellites      int64     // Number of satellites used in the navigation solution
+	DeadReckoning      int64     // Dead reckoning used
+}
+
+// newPUBX00 constructor
+func newPUBX00(s BaseSentence) (PUBX00, error) {
+	p := newParser(s)
+	p.AssertType(TypePUBX)
+	return PUBX00{
+		BaseSentence:       s,
+		Time:               p.Time(1, "time"),
+		Latitude:           p.Latitude(2, 3, "latitude"),
+		Longitude:          p.Longitude(4, 5, "longitude"),
+		Altitude:           p.Float64(6, "altitude"),
+		NavStatus:          p.EnumString(7, "navigation status", NoFixPUBX, DeadReckoningPUBX, StandAlone2DPUBX, StandAlone3DPUBX, Differential2DPUBX, Differential3DPUBX, GPSDeadReckoningPUBX, TimeOnlyPUBX),
+		HorizontalAccuracy: p.Float64(8, "horizontal accuracy"),
+		VerticalAccuracy:   p.Float64(9, "vertical accuracy"),
+		Speed:              p.Float64(10, "speed"),
+		Course:             p.Float64(11, "course"),
+		VerticalVelocity:   p.Float64(12, "vertical velocity"),
+		DifferentialAge:    p.Float64(13, "differential age"),
+		HDOP:               p.Float64(14, "hdop"),
+		VDOP:               p.Float64(15, "vdop"),
+		TDOP:               p.Float64(16, "tdop"),
+		NumSatellites:      p.Int64(17, "number of satellites"),
+		DeadReckoning:      p.Int64(19, "dead reckoning"),
+	}, p.Err()
+}
+
+// Position returns the reported position.
+func (m PUBX00) Position() Position {
+	return Position{Latitude: m.Latitude, Longitude: m.Longitude}
+}
@@ -0,0 +1,67 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var pubx00tests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  PUBX00
+}{
+	{
+		name: "good sentence",
+		raw:  "$PUBX,00,081350.00,4717.113210,N,00833.915187,E,546.589,G3,2.1,2.0,0.007,77.52,0.007,,0.92,1.19,0.77,9,0,0*5F",
+		msg: PUBX00{
+			Time:               Time{true, 8, 13, 50, 0, 0},
+			Latitude:           Latitude(MustParseGPS("4717.113210 N")),
+			Longitude:          Longitude(MustParseGPS("00833.915187 E")),
+			Altitude:           546.589,
+			NavStatus:          StandAlone3DPUBX,
+			HorizontalAccuracy: 2.1,
+			VerticalAccuracy:   2,
+			Speed:              0.007,
+			Course:             77.52,
+			VerticalVelocity:   0.007,
+			HDOP:               0.92,
+			VDOP:               1.19,
+			TDOP:               0.77,
+			NumSatellites:      9,
+		},
+	},
+	{
+		name: "invalid navigation status",
+		raw:  "$PUBX,00,081350.00,4717.113210,N,00833.915187,E,546.589,XX,2.1,2.0,0.007,77.52,0.007,,0.92,1.19,0.77,9,0,0*2B",
+		err:  "nmea: PUBX invalid navigation status: XX",
+	},
+	{
+		name: "invalid altitude",
+		raw:  "$PUBX,00,081350.00,4717.113210,N,00833.915187,E,x,G3,2.1,2.0,0.007,77.52,0.007,,0.92,1.19,0.77,9,0,0*0A",
+		err:  "nmea: PUBX invalid altitude: x",
+	},
+	{
+		name: "unsupported message",
+		raw:  "$PUBX,99,1*2E",
+		err:  "nmea: PUBX message '99' not supported",
+	},
+}
+
+func TestPUBX00(t *testing.T) {
+	for _, tt := range pubx00tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				pubx := m.(PUBX00)
+				pubx.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, pubx)
+			}
+		})
+	}
+}
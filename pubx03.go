@@ -0,0 +1,49 @@
+package nmea
+
+const (
+	// NotUsedPUBX satellite status, not used
+	NotUsedPUBX = "-"
+	// UsedPUBX satellite status, used in the navigation solution
+	UsedPUBX = "U"
+	// EphemerisPUBX satellite status, ephemeris available but not used
+	EphemerisPUBX = "e"
+)
+
+// PUBX03 is the satellite status (u-blox proprietary sentence PUBX,03)
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_pubx_03_u_blox_satellite_status
+type PUBX03 struct {
+	BaseSentence
+	NumSatellites int64             // Number of satellites tracked
+	Satellites    []PUBX03Satellite // Status of each tracked satellite
+}
+
+// PUBX03Satellite is the status of a single satellite in the PUBX,03 message.
+type PUBX03Satellite struct {
+	ID        int64  // Satellite ID
+	Status    string // Satellite status - -(not used), U-used, e-ephemeris available but not used
+	Azimuth   int64  // Azimuth in degrees, 0 - 359
+	Elevation int64  // Elevation in degrees, 0 - 90
+	CNO       int64  // Signal strength (C/N0) in dBHz, 0 - 99
+	LockTime  int64  // Satellite carrier lock time in seconds, 0 - 64
+}
+
+// newPUBX03 constructor
+func newPUBX03(s BaseSentence) (PUBX03, error) {
+	p := newParser(s)
+	p.AssertType(TypePUBX)
+	m := PUBX03{
+		BaseSentence:  s,
+		NumSatellites: p.Int64(1, "number of satellites"),
+	}
+	for i := 0; i < int(m.NumSatellites) && p.Err() == nil; i++ {
+		m.Satellites = append(m.Satellites, PUBX03Satellite{
+			ID:        p.Int64(2+i*6, "satellite ID"),
+			Status:    p.EnumString(3+i*6, "satellite status", NotUsedPUBX, UsedPUBX, EphemerisPUBX),
+			Azimuth:   p.Int64(4+i*6, "azimuth"),
+			Elevation: p.Int64(5+i*6, "elevation"),
+			CNO:       p.Int64(6+i*6, "signal strength"),
+			LockTime:  p.Int64(7+i*6, "lock time"),
+		})
+	}
+	return m, p.Err()
+}
@@ -0,0 +1,61 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var pubx03tests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  PUBX03
+}{
+	{
+		name: "good sentence",
+		raw:  "$PUBX,03,02,23,-,,,45,010,08,U,067,31,42,025*5D",
+		msg: PUBX03{
+			NumSatellites: 2,
+			Satellites: []PUBX03Satellite{
+				{ID: 23, Status: NotUsedPUBX, CNO: 45, LockTime: 10},
+				{ID: 8, Status: UsedPUBX, Azimuth: 67, Elevation: 31, CNO: 42, LockTime: 25},
+			},
+		},
+	},
+	{
+		name: "missing satellite",
+		raw:  "$PUBX,03,02,23,-,,,45,010*02",
+		err:  "nmea: PUBX invalid satellite ID: index out of range",
+	},
+	{
+		name: "invalid satellite status",
+		raw:  "$PUBX,03,01,23,X,,,45,010*74",
+		err:  "nmea: PUBX invalid satellite status: X",
+	},
+}
+
+func TestPUBX03(t *testing.T) {
+	for _, tt := range pubx03tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				pubx := m.(PUBX03)
+				pubx.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, pubx)
+			}
+		})
+	}
+}
+
+func TestPUBX03Satellites(t *testing.T) {
+	m, err := Parse("$PUBX,03,11,23,-,,,45,010,29,-,,,46,013,07,-,,,42,015,08,U,067,31,42,025,10,U,195,33,46,026,18,U,326,08,39,026,17,-,,,32,015,26,U,306,66,48,025,27,U,073,10,36,026,28,U,089,61,46,024,15,-,,,39,014*0D")
+	assert.NoError(t, err)
+	pubx := m.(PUBX03)
+	assert.Len(t, pubx.Satellites, 11)
+	assert.Equal(t, PUBX03Satellite{ID: 15, Status: NotUsedPUBX, CNO: 39, LockTime: 14}, pubx.Satellites[10])
+}
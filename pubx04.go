@@ -0,0 +1,51 @@
+package nmea
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	// DefaultLeapSecondsPUBX marks the number of leap seconds as the firmware default
+	DefaultLeapSecondsPUBX = "D"
+)
+
+// PUBX04 is the time of day and clock information (u-blox proprietary sentence PUBX,04)
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_pubx_04_u_blox_time_of_day_and_clock_information
+type PUBX04 struct {
+	BaseSentence
+	Time               Time    // UTC time
+	Date               Date    // UTC date
+	TimeOfWeek         float64 // UTC time of week in seconds
+	Week               int64   // UTC week number
+	LeapSeconds        int64   // Number of leap seconds
+	DefaultLeapSeconds bool    // Whether the number of leap seconds is the firmware default
+	ClockBias          int64   // Receiver clock bias in nanoseconds
+	ClockDrift         float64 // Receiver clock drift in nanoseconds per second
+	Granularity        int64   // Time pulse granularity in nanoseconds
+}
+
+// newPUBX04 constructor
+func newPUBX04(s BaseSentence) (PUBX04, error) {
+	p := newParser(s)
+	p.AssertType(TypePUBX)
+	m := PUBX04{
+		BaseSentence: s,
+		Time:         p.Time(1, "time"),
+		Date:         p.Date(2, "date"),
+		TimeOfWeek:   p.Float64(3, "time of week"),
+		Week:         p.Int64(4, "week number"),
+	}
+	if leap := p.String(5, "leap seconds"); leap != "" && p.Err() == nil {
+		m.DefaultLeapSeconds = strings.HasSuffix(leap, DefaultLeapSecondsPUBX)
+		v, err := strconv.ParseInt(strings.TrimSuffix(leap, DefaultLeapSecondsPUBX), 10, 64)
+		if err != nil {
+			p.SetErr("leap seconds", leap)
+		}
+		m.LeapSeconds = v
+	}
+	m.ClockBias = p.Int64(6, "clock bias")
+	m.ClockDrift = p.Float64(7, "clock drift")
+	m.Granularity = p.Int64(8, "time pulse granularity")
+	return m, p.Err()
+}
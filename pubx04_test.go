@@ -0,0 +1,66 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var pubx04tests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  PUBX04
+}{
+	{
+		name: "good sentence with default leap seconds",
+		raw:  "$PUBX,04,073731.00,091202,113851.00,1196,15D,1930035,-2660.664,43,*5D",
+		msg: PUBX04{
+			Time:               Time{true, 7, 37, 31, 0, 0},
+			Date:               Date{true, 9, 12, 2},
+			TimeOfWeek:         113851,
+			Week:               1196,
+			LeapSeconds:        15,
+			DefaultLeapSeconds: true,
+			ClockBias:          1930035,
+			ClockDrift:         -2660.664,
+			Granularity:        43,
+		},
+	},
+	{
+		name: "good sentence",
+		raw:  "$PUBX,04,073731.00,091202,113851.00,1196,16,1930035,-2660.664,43,*1A",
+		msg: PUBX04{
+			Time:        Time{true, 7, 37, 31, 0, 0},
+			Date:        Date{true, 9, 12, 2},
+			TimeOfWeek:  113851,
+			Week:        1196,
+			LeapSeconds: 16,
+			ClockBias:   1930035,
+			ClockDrift:  -2660.664,
+			Granularity: 43,
+		},
+	},
+	{
+		name: "invalid leap seconds",
+		raw:  "$PUBX,04,073731.00,091202,113851.00,1196,1xD,1930035,-2660.664,43,*10",
+		err:  "nmea: PUBX invalid leap seconds: 1xD",
+	},
+}
+
+func TestPUBX04(t *testing.T) {
+	for _, tt := range pubx04tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				pubx := m.(PUBX04)
+				pubx.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, pubx)
+			}
+		})
+	}
+}
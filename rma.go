@@ -0,0 +1,60 @@
+package nmea
+
+const (
+	// TypeRMA type for RMA sentences
+	TypeRMA = "RMA"
+	// ValidRMA character
+	ValidRMA = "A"
+	// InvalidRMA character, blink, cycle or SNR warning
+	InvalidRMA = "V"
+)
+
+// RMA is the Recommended Minimum Specific Loran-C data.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_rma_recommended_minimum_navigation_information
+type RMA struct {
+	BaseSentence
+	Validity        string    // validity - A-ok, V-blink, cycle or SNR warning
+	Latitude        Latitude  // Latitude
+	Longitude       Longitude // Longitude
+	TimeDifferenceA float64   // Time difference A in microseconds
+	TimeDifferenceB float64   // Time difference B in microseconds
+	Speed           float64   // Speed over ground in knots
+	Course          Bearing   // True course over ground
+	Variation       float64   // Magnetic variation
+	FAAMode         string    // FAA mode indicator (NMEA 2.3 and later)
+}
+
+// newRMA constructor
+func newRMA(s BaseSentence) (RMA, error) {
+	p := newParser(s)
+	p.AssertType(TypeRMA)
+	p.AssertFieldCount(11, 12)
+	m := RMA{
+		BaseSentence:    s,
+		Validity:        p.EnumString(0, "validity", ValidRMA, InvalidRMA),
+		Latitude:        p.Latitude(1, 2, "latitude"),
+		Longitude:       p.Longitude(3, 4, "longitude"),
+		TimeDifferenceA: p.Float64(5, "time difference A"),
+		TimeDifferenceB: p.Float64(6, "time difference B"),
+		Speed:           p.Float64(7, "speed"),
+		Course:          NewBearing(p.Float64(8, "course")),
+		Variation:       p.Float64(9, "variation"),
+	}
+	if p.EnumString(10, "direction", West, East) == West {
+		m.Variation = 0 - m.Variation
+	}
+	if len(m.Fields) > 11 {
+		m.FAAMode = p.EnumString(11, "FAA mode", FAAModeAutonomous, FAAModeDifferential, FAAModeEstimated, FAAModeManual, FAAModeSimulator, FAAModeNotValid, FAAModePrecise)
+	}
+	return m, p.Err()
+}
+
+// MagneticCourse returns the course over ground relative to magnetic north.
+func (m RMA) MagneticCourse() Bearing {
+	return Bearing(MagneticFromTrue(float64(m.Course), m.Variation))
+}
+
+// Position returns the reported position.
+func (m RMA) Position() Position {
+	return Position{Latitude: m.Latitude, Longitude: m.Longitude}
+}
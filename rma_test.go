@@ -0,0 +1,71 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var rmatests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  RMA
+}{
+	{
+		name: "good sentence",
+		raw:  "$LCRMA,A,4916.45,N,12311.12,W,14162.8,36169.2,5.5,54.7,20.3,E,A*1D",
+		msg: RMA{
+			Validity:        ValidRMA,
+			Latitude:        Latitude(MustParseGPS("4916.45 N")),
+			Longitude:       Longitude(MustParseGPS("12311.12 W")),
+			TimeDifferenceA: 14162.8,
+			TimeDifferenceB: 36169.2,
+			Speed:           5.5,
+			Course:          54.7,
+			Variation:       20.3,
+			FAAMode:         FAAModeAutonomous,
+		},
+	},
+	{
+		name: "good sentence without FAA mode",
+		raw:  "$LCRMA,V,4916.45,N,12311.12,W,14162.8,36169.2,5.5,54.7,20.3,W*75",
+		msg: RMA{
+			Validity:        InvalidRMA,
+			Latitude:        Latitude(MustParseGPS("4916.45 N")),
+			Longitude:       Longitude(MustParseGPS("12311.12 W")),
+			TimeDifferenceA: 14162.8,
+			TimeDifferenceB: 36169.2,
+			Speed:           5.5,
+			Course:          54.7,
+			Variation:       -20.3,
+		},
+	},
+	{
+		name: "invalid validity",
+		raw:  "$LCRMA,X,4916.45,N,12311.12,W,14162.8,36169.2,5.5,54.7,20.3,E*69",
+		err:  "nmea: LCRMA invalid validity: X",
+	},
+	{
+		name: "invalid time difference",
+		raw:  "$LCRMA,A,4916.45,N,12311.12,W,x,36169.2,5.5,54.7,20.3,E*2E",
+		err:  "nmea: LCRMA invalid time difference A: x",
+	},
+}
+
+func TestRMA(t *testing.T) {
+	for _, tt := range rmatests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				rma := m.(RMA)
+				rma.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, rma)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package nmea
+
+const (
+	// TypeRMB type for RMB sentences
+	TypeRMB = "RMB"
+	// ValidRMB character
+	ValidRMB = "A"
+	// InvalidRMB character
+	InvalidRMB = "V"
+	// ArrivedRMB character, the arrival circle has been entered
+	ArrivedRMB = "A"
+	// NotArrivedRMB character, the arrival circle has not been entered
+	NotArrivedRMB = "V"
+)
+
+// RMB is the Recommended Minimum Navigation Information.
+// http://aprs.gids.nl/nmea/#rmb
+type RMB struct {
+	BaseSentence
+	Validity              string    // validity - A-ok, V-invalid
+	CrossTrackError       float64   // Cross-track error in nautical miles
+	SteerDirection        string    // Direction to steer, L or R
+	OriginWaypointID      string    // Origin waypoint ID
+	DestinationWaypointID string    // Destination waypoint ID
+	DestinationLatitude   Latitude  // Destination waypoint latitude
+	DestinationLongitude  Longitude // Destination waypoint longitude
+	Range                 float64   // Range to destination in nautical miles
+	BearingTrue           float64   // True bearing to destination in degrees
+	Velocity              float64   // Destination closing velocity in knots
+	ArrivalStatus         string    // Arrival status - A-arrived, V-not arrived
+	FAAMode               string    // FAA mode indicator (NMEA 2.3 and later)
+}
+
+// newRMB constructor
+func newRMB(s BaseSentence) (RMB, error) {
+	p := newParser(s)
+	p.AssertType(TypeRMB)
+	p.AssertFieldCount(13, 14)
+	m := RMB{
+		BaseSentence:          s,
+		Validity:              p.EnumString(0, "validity", ValidRMB, InvalidRMB),
+		CrossTrackError:       p.Float64(1, "cross-track error"),
+		SteerDirection:        p.EnumString(2, "steer direction", Left, Right),
+		OriginWaypointID:      p.String(3, "origin waypoint ID"),
+		DestinationWaypointID: p.String(4, "destination waypoint ID"),
+		DestinationLatitude:   p.Latitude(5, 6, "destination latitude"),
+		DestinationLongitude:  p.Longitude(7, 8, "destination longitude"),
+		Range:                 p.Float64(9, "range"),
+		BearingTrue:           p.Float64(10, "true bearing"),
+		Velocity:              p.Float64(11, "velocity"),
+		ArrivalStatus:         p.EnumString(12, "arrival status", ArrivedRMB, NotArrivedRMB),
+	}
+	if len(m.Fields) > 13 {
+		m.FAAMode = p.EnumString(13, "FAA mode", FAAModeAutonomous, FAAModeDifferential, FAAModeEstimated, FAAModeManual, FAAModeSimulator, FAAModeNotValid, FAAModePrecise)
+	}
+	return m, p.Err()
+}
@@ -0,0 +1,92 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var rmbtests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  RMB
+}{
+	{
+		name: "good sentence",
+		raw:  "$GPRMB,A,0.66,L,003,004,4917.24,N,12309.57,W,001.3,052.5,000.5,V*20",
+		msg: RMB{
+			Validity:              ValidRMB,
+			CrossTrackError:       0.66,
+			SteerDirection:        Left,
+			OriginWaypointID:      "003",
+			DestinationWaypointID: "004",
+			DestinationLatitude:   Latitude(MustParseGPS("4917.24 N")),
+			DestinationLongitude:  Longitude(MustParseGPS("12309.57 W")),
+			Range:                 1.3,
+			BearingTrue:           52.5,
+			Velocity:              0.5,
+			ArrivalStatus:         NotArrivedRMB,
+		},
+	},
+	{
+		name: "good sentence with FAA mode",
+		raw:  "$GPRMB,A,0.66,L,003,004,4917.24,N,12309.57,W,001.3,052.5,000.5,V,D*48",
+		msg: RMB{
+			Validity:              ValidRMB,
+			CrossTrackError:       0.66,
+			SteerDirection:        Left,
+			OriginWaypointID:      "003",
+			DestinationWaypointID: "004",
+			DestinationLatitude:   Latitude(MustParseGPS("4917.24 N")),
+			DestinationLongitude:  Longitude(MustParseGPS("12309.57 W")),
+			Range:                 1.3,
+			BearingTrue:           52.5,
+			Velocity:              0.5,
+			ArrivalStatus:         NotArrivedRMB,
+			FAAMode:               FAAModeDifferential,
+		},
+	},
+	{
+		name: "invalid validity",
+		raw:  "$GPRMB,X,0.66,L,003,004,4917.24,N,12309.57,W,001.3,052.5,000.5,V*39",
+		err:  "nmea: GPRMB invalid validity: X",
+	},
+	{
+		name: "invalid steer direction",
+		raw:  "$GPRMB,A,0.66,X,003,004,4917.24,N,12309.57,W,001.3,052.5,000.5,V*34",
+		err:  "nmea: GPRMB invalid steer direction: X",
+	},
+	{
+		name: "invalid velocity",
+		raw:  "$GPRMB,A,0.66,L,003,004,4917.24,N,12309.57,W,001.3,052.5,0x0.5,V*68",
+		err:  "nmea: GPRMB invalid velocity: 0x0.5",
+	},
+	{
+		name: "invalid arrival status",
+		raw:  "$GPRMB,A,0.66,L,003,004,4917.24,N,12309.57,W,001.3,052.5,000.5,X*2E",
+		err:  "nmea: GPRMB invalid arrival status: X",
+	},
+	{
+		name: "invalid FAA mode",
+		raw:  "$GPRMB,A,0.66,L,003,004,4917.24,N,12309.57,W,001.3,052.5,000.5,V,X*54",
+		err:  "nmea: GPRMB invalid FAA mode: X",
+	},
+}
+
+func TestRMB(t *testing.T) {
+	for _, tt := range rmbtests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				rmb := m.(RMB)
+				rmb.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, rmb)
+			}
+		})
+	}
+}
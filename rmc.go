@@ -1,5 +1,7 @@
 package nmea
 
+import "time"
+
 const (
 	// TypeRMC type for RMC sentences
 	TypeRMC = "RMC"
@@ -13,33 +15,51 @@ const (
 // http://aprs.gids.nl/nmea/#rmc
 type RMC struct {
 	BaseSentence
-	Time      Time    // Time Stamp
-	Validity  string  // validity - A-ok, V-invalid
-	Latitude  float64 // Latitude
-	Longitude float64 // Longitude
-	Speed     float64 // Speed in knots
-	Course    float64 // True course
-	Date      Date    // Date
-	Variation float64 // Magnetic variation
+	Time      Time      // Time Stamp
+	Validity  string    // validity - A-ok, V-invalid
+	Latitude  Latitude  // Latitude
+	Longitude Longitude // Longitude
+	Speed     Speed     // Speed over ground
+	Course    Bearing   // True course
+	Date      Date      // Date
+	Variation float64   // Magnetic variation
 }
 
+// rmcLayout is the field layout of RMC sentences.
+var rmcLayout = newLayout(RMC{}, TypeRMC, []int{11, 12, 13},
+	layoutField{name: "Time", context: "time", kind: timeField},
+	layoutField{name: "Validity", context: "validity", kind: enumField, options: []string{ValidRMC, InvalidRMC}},
+	layoutField{name: "Latitude", context: "latitude", kind: latitudeField},
+	layoutField{name: "Longitude", context: "longitude", kind: longitudeField},
+	layoutField{name: "Speed", context: "speed", kind: floatField},
+	layoutField{name: "Course", context: "course", kind: bearingField},
+	layoutField{name: "Date", context: "date", kind: dateField},
+	layoutField{name: "Variation", context: "variation", kind: signedField, options: []string{East, West}, direction: "direction"},
+)
+
 // newRMC constructor
 func newRMC(s BaseSentence) (RMC, error) {
-	p := newParser(s)
-	p.AssertType(TypeRMC)
-	m := RMC{
-		BaseSentence: s,
-		Time:         p.Time(0, "time"),
-		Validity:     p.EnumString(1, "validity", ValidRMC, InvalidRMC),
-		Latitude:     p.LatLong(2, 3, "latitude"),
-		Longitude:    p.LatLong(4, 5, "longitude"),
-		Speed:        p.Float64(6, "speed"),
-		Course:       p.Float64(7, "course"),
-		Date:         p.Date(8, "date"),
-		Variation:    p.Float64(9, "variation"),
-	}
-	if p.EnumString(10, "direction", West, East) == West {
-		m.Variation = 0 - m.Variation
-	}
-	return m, p.Err()
+	var m RMC
+	err := rmcLayout.parse(s, &m)
+	return m, err
+}
+
+// Encode encodes m into an RMC sentence using the talker of m.
+func (m RMC) Encode() string {
+	return rmcLayout.encode(m.Talker, m)
+}
+
+// DateTime returns the date and time of the fix in the given location, UTC if nil.
+func (m RMC) DateTime(loc *time.Location) time.Time {
+	return DateTime(loc, m.Date, m.Time)
+}
+
+// MagneticCourse returns the course over ground relative to magnetic north.
+func (m RMC) MagneticCourse() Bearing {
+	return Bearing(MagneticFromTrue(float64(m.Course), m.Variation))
+}
+
+// Position returns the reported position.
+func (m RMC) Position() Position {
+	return Position{Latitude: m.Latitude, Longitude: m.Longitude}
 }
@@ -2,6 +2,7 @@ package nmea
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -16,42 +17,42 @@ var rmctests = []struct {
 		name: "good sentence A",
 		raw:  "$GNRMC,220516,A,5133.82,N,00042.24,W,173.8,231.8,130694,004.2,W*6E",
 		msg: RMC{
-			Time:      Time{true, 22, 05, 16, 0},
+			Time:      Time{true, 22, 05, 16, 0, 0},
 			Validity:  "A",
 			Speed:     173.8,
 			Course:    231.8,
 			Date:      Date{true, 13, 06, 94},
 			Variation: -4.2,
-			Latitude:  MustParseGPS("5133.82 N"),
-			Longitude: MustParseGPS("00042.24 W"),
+			Latitude:  Latitude(MustParseGPS("5133.82 N")),
+			Longitude: Longitude(MustParseGPS("00042.24 W")),
 		},
 	},
 	{
 		name: "good sentence B",
 		raw:  "$GNRMC,142754.0,A,4302.539570,N,07920.379823,W,0.0,,070617,0.0,E,A*21",
 		msg: RMC{
-			Time:      Time{true, 14, 27, 54, 0},
+			Time:      Time{true, 14, 27, 54, 0, 0},
 			Validity:  "A",
 			Speed:     0,
 			Course:    0,
 			Date:      Date{true, 7, 6, 17},
 			Variation: 0,
-			Latitude:  MustParseGPS("4302.539570 N"),
-			Longitude: MustParseGPS("07920.379823 W"),
+			Latitude:  Latitude(MustParseGPS("4302.539570 N")),
+			Longitude: Longitude(MustParseGPS("07920.379823 W")),
 		},
 	},
 	{
 		name: "good sentence C",
 		raw:  "$GNRMC,100538.00,A,5546.27711,N,03736.91144,E,0.061,,260318,,,A*60",
 		msg: RMC{
-			Time:      Time{true, 10, 5, 38, 0},
+			Time:      Time{true, 10, 5, 38, 0, 0},
 			Validity:  "A",
 			Speed:     0.061,
 			Course:    0,
 			Date:      Date{true, 26, 3, 18},
 			Variation: 0,
-			Latitude:  MustParseGPS("5546.27711 N"),
-			Longitude: MustParseGPS("03736.91144 E"),
+			Latitude:  Latitude(MustParseGPS("5546.27711 N")),
+			Longitude: Longitude(MustParseGPS("03736.91144 E")),
 		},
 	},
 	{
@@ -63,28 +64,28 @@ var rmctests = []struct {
 		name: "good sentence A",
 		raw:  "$GPRMC,220516,A,5133.82,N,00042.24,W,173.8,231.8,130694,004.2,W*70",
 		msg: RMC{
-			Time:      Time{true, 22, 5, 16, 0},
+			Time:      Time{true, 22, 5, 16, 0, 0},
 			Validity:  "A",
 			Speed:     173.8,
 			Course:    231.8,
 			Date:      Date{true, 13, 6, 94},
 			Variation: -4.2,
-			Latitude:  MustParseGPS("5133.82 N"),
-			Longitude: MustParseGPS("00042.24 W"),
+			Latitude:  Latitude(MustParseGPS("5133.82 N")),
+			Longitude: Longitude(MustParseGPS("00042.24 W")),
 		},
 	},
 	{
 		name: "good sentence B",
 		raw:  "$GPRMC,142754.0,A,4302.539570,N,07920.379823,W,0.0,,070617,0.0,E,A*3F",
 		msg: RMC{
-			Time:      Time{true, 14, 27, 54, 0},
+			Time:      Time{true, 14, 27, 54, 0, 0},
 			Validity:  "A",
 			Speed:     0,
 			Course:    0,
 			Date:      Date{true, 7, 6, 17},
 			Variation: 0,
-			Latitude:  MustParseGPS("4302.539570 N"),
-			Longitude: MustParseGPS("07920.379823 W"),
+			Latitude:  Latitude(MustParseGPS("4302.539570 N")),
+			Longitude: Longitude(MustParseGPS("07920.379823 W")),
 		},
 	},
 	{
@@ -92,6 +93,16 @@ var rmctests = []struct {
 		raw:  "$GPRMC,220516,D,5133.82,N,00042.24,W,173.8,231.8,130694,004.2,W*75",
 		err:  "nmea: GPRMC invalid validity: D",
 	},
+	{
+		name: "truncated sentence",
+		raw:  "$GNRMC,220516,A,5133.82,N,00042.24,W,173.8*3E",
+		err:  "nmea: GNRMC invalid number of fields: 7",
+	},
+	{
+		name: "extra fields",
+		raw:  "$GNRMC,220516,A,5133.82,N,00042.24,W,173.8,231.8,130694,004.2,W,A,S,X*08",
+		err:  "nmea: GNRMC invalid number of fields: 14",
+	},
 }
 
 func TestRMC(t *testing.T) {
@@ -110,3 +121,23 @@ func TestRMC(t *testing.T) {
 		})
 	}
 }
+
+func TestRMCDateTime(t *testing.T) {
+	m, err := Parse("$GNRMC,220516,A,5133.82,N,00042.24,W,173.8,231.8,130694,004.2,W*6E")
+	assert.NoError(t, err)
+	expected := time.Date(1994, time.June, 13, 22, 5, 16, 0, time.UTC)
+	assert.Equal(t, expected, m.(RMC).DateTime(nil))
+
+	loc := time.FixedZone("UTC+2", 2*60*60)
+	local := m.(RMC).DateTime(loc)
+	assert.Equal(t, loc, local.Location())
+	assert.Equal(t, 14, local.Day())
+	assert.Equal(t, 0, local.Hour())
+	assert.True(t, expected.Equal(local))
+}
+
+func TestRMCMagneticCourse(t *testing.T) {
+	m, err := Parse("$GNRMC,220516,A,5133.82,N,00042.24,W,173.8,231.8,130694,004.2,W*6E")
+	assert.NoError(t, err)
+	assert.InDelta(t, 236.0, m.(RMC).MagneticCourse().Degrees(), 1e-9)
+}
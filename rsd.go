@@ -0,0 +1,53 @@
+package nmea
+
+const (
+	// TypeRSD type for RSD sentences
+	TypeRSD = "RSD"
+	// CourseUpRSD display rotation character
+	CourseUpRSD = "C"
+	// HeadUpRSD display rotation character
+	HeadUpRSD = "H"
+	// NorthUpRSD display rotation character
+	NorthUpRSD = "N"
+)
+
+// RSD is the radar system data, the setting of the radar display.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_rsd_radar_system_data
+type RSD struct {
+	BaseSentence
+	Origin1Range    float64 // Origin 1 range from own ship
+	Origin1Bearing  float64 // Origin 1 bearing in degrees from 0
+	VRM1            float64 // Variable range marker 1
+	EBL1            float64 // Bearing line 1 in degrees from 0
+	Origin2Range    float64 // Origin 2 range from own ship
+	Origin2Bearing  float64 // Origin 2 bearing in degrees from 0
+	VRM2            float64 // Variable range marker 2
+	EBL2            float64 // Bearing line 2 in degrees from 0
+	CursorRange     float64 // Cursor range from own ship
+	CursorBearing   float64 // Cursor bearing in degrees clockwise from 0
+	RangeScale      float64 // Range scale in use
+	RangeUnits      string  // Range units, K, N or S
+	DisplayRotation string  // Display rotation - C-course up, H-head up, N-north up
+}
+
+// newRSD constructor
+func newRSD(s BaseSentence) (RSD, error) {
+	p := newParser(s)
+	p.AssertType(TypeRSD)
+	return RSD{
+		BaseSentence:    s,
+		Origin1Range:    p.Float64(0, "origin 1 range"),
+		Origin1Bearing:  p.Float64(1, "origin 1 bearing"),
+		VRM1:            p.Float64(2, "VRM 1"),
+		EBL1:            p.Float64(3, "EBL 1"),
+		Origin2Range:    p.Float64(4, "origin 2 range"),
+		Origin2Bearing:  p.Float64(5, "origin 2 bearing"),
+		VRM2:            p.Float64(6, "VRM 2"),
+		EBL2:            p.Float64(7, "EBL 2"),
+		CursorRange:     p.Float64(8, "cursor range"),
+		CursorBearing:   p.Float64(9, "cursor bearing"),
+		RangeScale:      p.Float64(10, "range scale"),
+		RangeUnits:      p.EnumString(11, "range units", DistanceKilometers, DistanceNauticalMiles, DistanceStatuteMiles),
+		DisplayRotation: p.EnumString(12, "display rotation", CourseUpRSD, HeadUpRSD, NorthUpRSD),
+	}, p.Err()
+}
@@ -0,0 +1,62 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var rsdtests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  RSD
+}{
+	{
+		name: "good sentence",
+		raw:  "$RARSD,0.3,12.5,1.2,90.0,,,,,2.1,45.3,3.0,N,H*6F",
+		msg: RSD{
+			Origin1Range:    0.3,
+			Origin1Bearing:  12.5,
+			VRM1:            1.2,
+			EBL1:            90.0,
+			CursorRange:     2.1,
+			CursorBearing:   45.3,
+			RangeScale:      3.0,
+			RangeUnits:      DistanceNauticalMiles,
+			DisplayRotation: HeadUpRSD,
+		},
+	},
+	{
+		name: "invalid cursor bearing",
+		raw:  "$RARSD,0.3,12.5,1.2,90.0,,,,,2.1,x5.3,3.0,N,H*23",
+		err:  "nmea: RARSD invalid cursor bearing: x5.3",
+	},
+	{
+		name: "invalid range units",
+		raw:  "$RARSD,0.3,12.5,1.2,90.0,,,,,2.1,45.3,3.0,X,H*79",
+		err:  "nmea: RARSD invalid range units: X",
+	},
+	{
+		name: "invalid display rotation",
+		raw:  "$RARSD,0.3,12.5,1.2,90.0,,,,,2.1,45.3,3.0,N,X*7F",
+		err:  "nmea: RARSD invalid display rotation: X",
+	},
+}
+
+func TestRSD(t *testing.T) {
+	for _, tt := range rsdtests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				rsd := m.(RSD)
+				rsd.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, rsd)
+			}
+		})
+	}
+}
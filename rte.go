@@ -1,5 +1,7 @@
 package nmea
 
+import "fmt"
+
 const (
 	// TypeRTE type for RTE sentences
 	TypeRTE = "RTE"
@@ -18,7 +20,7 @@ type RTE struct {
 	SentenceNumber            int64    // Sentence number
 	ActiveRouteOrWaypointList string   // Current active route or waypoint list
 	Name                      string   // Name or number of active route
-	Idents                    []string // List of ident of waypoints
+	Idents                    []string // List of ident of waypoints, shares the backing array of Fields
 }
 
 // newRTE constructor
@@ -31,6 +33,71 @@ func newRTE(s BaseSentence) (RTE, error) {
 		SentenceNumber:            p.Int64(1, "sentence number"),
 		ActiveRouteOrWaypointList: p.EnumString(2, "active route or waypoint list", ActiveRoute, WaypointList),
 		Name:                      p.String(3, "name or number"),
-		Idents:                    p.ListString(4, "ident of waypoints"),
+		Idents:                    p.ListStringView(4, "ident of waypoints"),
 	}, p.Err()
 }
+
+// Route is a route assembled from a sequence of RTE sentences.
+type Route struct {
+	ActiveRouteOrWaypointList string   // Current active route or waypoint list
+	Name                      string   // Name or number of active route
+	Idents                    []string // List of ident of waypoints from all sentences
+}
+
+// Waypoints returns the waypoint locations of the route in route order,
+// looked up by ident in the given WPL sentences.
+// An error occurs if a waypoint of the route is not found.
+func (r Route) Waypoints(wpls []WPL) ([]WPL, error) {
+	idents := make(map[string]WPL, len(wpls))
+	for _, w := range wpls {
+		idents[w.Ident] = w
+	}
+	waypoints := make([]WPL, 0, len(r.Idents))
+	for _, ident := range r.Idents {
+		w, ok := idents[ident]
+		if !ok {
+			return nil, fmt.Errorf("nmea: route '%s' waypoint not found: %s", r.Name, ident)
+		}
+		waypoints = append(waypoints, w)
+	}
+	return waypoints, nil
+}
+
+// RouteAggregator concatenates the waypoint lists of a route that spans
+// multiple RTE sentences.
+type RouteAggregator struct {
+	route Route
+	seq   sequence
+}
+
+// Add adds the RTE sentence to the route being aggregated. The complete route
+// and true are returned once the last sentence of the sequence has been added.
+// A sentence with sentence number 1 always starts a new route.
+func (a *RouteAggregator) Add(m RTE) (Route, bool, error) {
+	if m.SentenceNumber == 1 {
+		a.route = Route{
+			ActiveRouteOrWaypointList: m.ActiveRouteOrWaypointList,
+			Name:                      m.Name,
+		}
+	}
+	done, err := a.seq.add(TypeRTE, m.NumberOfSentences, m.SentenceNumber)
+	if err == nil && m.Name != a.route.Name {
+		err = fmt.Errorf("nmea: RTE sentence %d does not belong to route '%s'", m.SentenceNumber, a.route.Name)
+	}
+	if err != nil {
+		a.Reset()
+		return Route{}, false, err
+	}
+	a.route.Idents = append(a.route.Idents, m.Idents...)
+	if !done {
+		return Route{}, false, nil
+	}
+	route := a.route
+	a.Reset()
+	return route, true, nil
+}
+
+// Reset discards the route being aggregated.
+func (a *RouteAggregator) Reset() {
+	*a = RouteAggregator{}
+}
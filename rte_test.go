@@ -61,3 +61,86 @@ func TestRTE(t *testing.T) {
 		})
 	}
 }
+
+func TestRouteAggregator(t *testing.T) {
+	var a RouteAggregator
+	for i, raw := range []string{
+		"$IIRTE,2,1,c,0,PBRCPK,PBRTO,PTELGR,PPLAND,PYAMBU*71",
+		"$IIRTE,2,2,c,0,PPFAIR,PWARRN,PMORTL,PLISMR*05",
+	} {
+		m, err := Parse(raw)
+		assert.NoError(t, err)
+		route, done, err := a.Add(m.(RTE))
+		assert.NoError(t, err)
+		if i == 0 {
+			assert.False(t, done)
+			continue
+		}
+		assert.True(t, done)
+		assert.Equal(t, Route{
+			ActiveRouteOrWaypointList: ActiveRoute,
+			Name:                      "0",
+			Idents:                    []string{"PBRCPK", "PBRTO", "PTELGR", "PPLAND", "PYAMBU", "PPFAIR", "PWARRN", "PMORTL", "PLISMR"},
+		}, route)
+	}
+}
+
+func TestRouteAggregatorSingle(t *testing.T) {
+	var a RouteAggregator
+	route, done, err := a.Add(RTE{
+		NumberOfSentences:         1,
+		SentenceNumber:            1,
+		ActiveRouteOrWaypointList: WaypointList,
+		Name:                      "Rte 1",
+		Idents:                    []string{"411", "412"},
+	})
+	assert.NoError(t, err)
+	assert.True(t, done)
+	assert.Equal(t, Route{
+		ActiveRouteOrWaypointList: WaypointList,
+		Name:                      "Rte 1",
+		Idents:                    []string{"411", "412"},
+	}, route)
+}
+
+func TestRouteAggregatorErrors(t *testing.T) {
+	var a RouteAggregator
+	_, _, err := a.Add(RTE{NumberOfSentences: 3, SentenceNumber: 2, Name: "0"})
+	assert.EqualError(t, err, "nmea: RTE unexpected sentence number: 2")
+
+	_, done, err := a.Add(RTE{NumberOfSentences: 3, SentenceNumber: 1, Name: "0"})
+	assert.NoError(t, err)
+	assert.False(t, done)
+	_, _, err = a.Add(RTE{NumberOfSentences: 3, SentenceNumber: 3, Name: "0"})
+	assert.EqualError(t, err, "nmea: RTE unexpected sentence number: 3")
+
+	_, _, err = a.Add(RTE{NumberOfSentences: 3, SentenceNumber: 1, Name: "0"})
+	assert.NoError(t, err)
+	_, _, err = a.Add(RTE{NumberOfSentences: 3, SentenceNumber: 2, Name: "1"})
+	assert.EqualError(t, err, "nmea: RTE sentence 2 does not belong to route '0'")
+
+	_, _, err = a.Add(RTE{NumberOfSentences: 3, SentenceNumber: 1, Name: "0"})
+	assert.NoError(t, err)
+	_, _, err = a.Add(RTE{NumberOfSentences: 4, SentenceNumber: 2, Name: "0"})
+	assert.EqualError(t, err, "nmea: RTE unexpected number of sentences: 4")
+}
+
+func TestRouteWaypoints(t *testing.T) {
+	var wpls []WPL
+	for _, raw := range []string{
+		"$IIWPL,5503.4530,N,01037.2742,E,411*6F",
+		"$IIWPL,3356.4650,S,15124.5567,E,412*70",
+	} {
+		m, err := Parse(raw)
+		assert.NoError(t, err)
+		wpls = append(wpls, m.(WPL))
+	}
+	route := Route{Name: "Rte 1", Idents: []string{"412", "411"}}
+	waypoints, err := route.Waypoints(wpls)
+	assert.NoError(t, err)
+	assert.Equal(t, []WPL{wpls[1], wpls[0]}, waypoints)
+
+	route.Idents = append(route.Idents, "413")
+	_, err = route.Waypoints(wpls)
+	assert.EqualError(t, err, "nmea: route 'Rte 1' waypoint not found: 413")
+}
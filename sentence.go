@@ -1,8 +1,13 @@
 package nmea
 
 import (
+	"errors"
 	"fmt"
+	"reflect"
+	"runtime"
+	"strconv"
 	"strings"
+	"sync"
 )
 
 const (
@@ -17,6 +22,9 @@ const (
 
 	// ChecksumSep is the token to delimit the checksum of a sentence.
 	ChecksumSep = "*"
+
+	// TextEscape is the token to indicate a hex encoded reserved character in text fields.
+	TextEscape = "^"
 )
 
 // Sentence interface for all NMEA sentence
@@ -31,7 +39,7 @@ type Sentence interface {
 type BaseSentence struct {
 	Talker   string   // The talker id (e.g GP)
 	Type     string   // The data type (e.g GSA)
-	Fields   []string // Array of fields
+	Fields   []string // Array of fields, sub-strings of Raw that share its memory
 	Checksum string   // The Checksum
 	Raw      string   // The raw NMEA sentence received
 }
@@ -54,6 +62,27 @@ func (s BaseSentence) TalkerID() string {
 // String formats the sentence into a string
 func (s BaseSentence) String() string { return s.Raw }
 
+// ErrChecksum is matched by errors.Is for all ChecksumError values.
+var ErrChecksum = errors.New("nmea: sentence checksum mismatch")
+
+// ChecksumError is the error for a sentence whose checksum does not match its content.
+// The raw sentence is kept to tell bit errors on the line from truncated sentences.
+type ChecksumError struct {
+	Computed string // Checksum computed from the sentence content
+	Received string // Checksum at the end of the sentence
+	Raw      string // Raw sentence
+}
+
+// Error formats the error message.
+func (e *ChecksumError) Error() string {
+	return "nmea: sentence checksum mismatch [" + e.Computed + " != " + e.Received + "]"
+}
+
+// Is reports whether the target is ErrChecksum.
+func (e *ChecksumError) Is(target error) bool {
+	return target == ErrChecksum
+}
+
 // parseSentence parses a raw message into it's fields
 func parseSentence(raw string) (BaseSentence, error) {
 	raw = strings.TrimSpace(raw)
@@ -61,27 +90,43 @@ func parseSentence(raw string) (BaseSentence, error) {
 	if startIndex != 0 {
 		return BaseSentence{}, fmt.Errorf("nmea: sentence does not start with a '$' or '!'")
 	}
-	sumSepIndex := strings.Index(raw, ChecksumSep)
+	// Find the checksum separator, computing the checksum and counting
+	// the fields in the same pass
+	var (
+		sum         uint8
+		numFields   = 1
+		sumSepIndex = -1
+	)
+	for i := 1; i < len(raw); i++ {
+		c := raw[i]
+		if c == ChecksumSep[0] {
+			sumSepIndex = i
+			break
+		}
+		sum ^= c
+		if c == FieldSep[0] {
+			numFields++
+		}
+	}
 	if sumSepIndex == -1 {
 		return BaseSentence{}, fmt.Errorf("nmea: sentence does not contain checksum separator")
 	}
-	var (
-		fieldsRaw   = raw[startIndex+1 : sumSepIndex]
-		fields      = strings.Split(fieldsRaw, FieldSep)
-		checksumRaw = strings.ToUpper(raw[sumSepIndex+1:])
-		checksum    = xorChecksum(fieldsRaw)
-	)
+	checksumRaw := raw[sumSepIndex+1:]
 	// Validate the checksum
-	if checksum != checksumRaw {
-		return BaseSentence{}, fmt.Errorf(
-			"nmea: sentence checksum mismatch [%s != %s]", checksum, checksumRaw)
+	if !checksumMatch(sum, checksumRaw) {
+		return BaseSentence{}, &ChecksumError{
+			Computed: formatChecksum(sum),
+			Received: strings.ToUpper(checksumRaw),
+			Raw:      raw,
+		}
 	}
+	fields := splitFields(raw[startIndex+1:sumSepIndex], numFields)
 	talker, typ := parsePrefix(fields[0])
 	return BaseSentence{
 		Talker:   talker,
 		Type:     typ,
 		Fields:   fields[1:],
-		Checksum: checksumRaw,
+		Checksum: strings.ToUpper(checksumRaw),
 		Raw:      raw,
 	}, nil
 }
@@ -97,14 +142,72 @@ func parsePrefix(s string) (string, string) {
 	return s[:2], s[2:]
 }
 
+// splitFields splits the sentence body into its n comma separated fields.
+func splitFields(s string, n int) []string {
+	fields := make([]string, 0, n)
+	for i := 0; i < n-1; i++ {
+		j := strings.IndexByte(s, FieldSep[0])
+		fields = append(fields, s[:j])
+		s = s[j+1:]
+	}
+	return append(fields, s)
+}
+
+// hexValues maps the hexadecimal digits, in either case, to their value
+// and every other byte to -1, so a comparison against it needs no branches.
+var hexValues = func() (t [256]int16) {
+	for i := range t {
+		t[i] = -1
+	}
+	for i := 0; i < 16; i++ {
+		t["0123456789ABCDEF"[i]] = int16(i)
+		t["0123456789abcdef"[i]] = int16(i)
+	}
+	return t
+}()
+
+// checksumMatch reports whether s is the two digit hexadecimal form of the checksum.
+// A negative digit value keeps the combined value negative, so it never matches.
+func checksumMatch(checksum uint8, s string) bool {
+	return len(s) == 2 && hexValues[s[0]]<<4|hexValues[s[1]] == int16(checksum)
+}
+
+// formatChecksum formats the checksum as two uppercase hexadecimal digits.
+func formatChecksum(checksum uint8) string {
+	const digits = "0123456789ABCDEF"
+	return string([]byte{digits[checksum>>4], digits[checksum&0x0F]})
+}
+
 // xor all the bytes in a string an return it
-// as an uppercase hex string
 func xorChecksum(s string) string {
 	var checksum uint8
 	for i := 0; i < len(s); i++ {
 		checksum ^= s[i]
 	}
-	return fmt.Sprintf("%02X", checksum)
+	return formatChecksum(checksum)
+}
+
+// formatSentence joins the prefix and fields into a raw sentence
+// starting with the start token and ending with its checksum.
+func formatSentence(start, prefix string, fields []string) string {
+	body := prefix + FieldSep + strings.Join(fields, FieldSep)
+	return start + body + ChecksumSep + xorChecksum(body)
+}
+
+// formatInt formats an integer field, leaving it empty if the value is not set.
+func formatInt(v int64, valid bool) string {
+	if !valid {
+		return ""
+	}
+	return strconv.FormatInt(v, 10)
+}
+
+// formatFloat formats a decimal field, leaving it empty if the value is not set.
+func formatFloat(v float64, valid bool) string {
+	if !valid {
+		return ""
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
 }
 
 // Parse parses the given string into the correct sentence type.
@@ -113,6 +216,11 @@ func Parse(raw string) (Sentence, error) {
 	if err != nil {
 		return nil, err
 	}
+	return newSentence(s)
+}
+
+// newSentence parses the fields of the base sentence into the correct sentence type.
+func newSentence(s BaseSentence) (Sentence, error) {
 	if strings.HasPrefix(s.Raw, SentenceStart) {
 		switch s.Type {
 		case TypeRMC:
@@ -141,13 +249,260 @@ func Parse(raw string) (Sentence, error) {
 			return newWPL(s)
 		case TypeRTE:
 			return newRTE(s)
+		case TypeBOD:
+			return newBOD(s)
+		case TypeBWC:
+			return newBWC(s)
+		case TypeBWR:
+			return newBWR(s)
+		case TypeBWW:
+			return newBWW(s)
+		case TypeRMB:
+			return newRMB(s)
+		case TypeWNC:
+			return newWNC(s)
+		case TypeXTE:
+			return newXTE(s)
+		case TypeZFO:
+			return newZFO(s)
+		case TypeZTG:
+			return newZTG(s)
+		case TypeGRS:
+			return newGRS(s)
+		case TypeGST:
+			return newGST(s)
+		case TypeGBS:
+			return newGBS(s)
+		case TypeDTM:
+			return newDTM(s)
+		case TypeTTM:
+			return newTTM(s)
+		case TypeTLL:
+			return newTLL(s)
+		case TypeOSD:
+			return newOSD(s)
+		case TypeRSD:
+			return newRSD(s)
+		case TypeDSC:
+			return newDSC(s)
+		case TypeDSE:
+			return newDSE(s)
+		case TypeALM:
+			return newALM(s)
+		case TypeTXT:
+			return newTXT(s)
+		case TypeALR:
+			return newALR(s)
+		case TypeACK:
+			return newACK(s)
+		case TypeHSC:
+			return newHSC(s)
+		case TypeVDR:
+			return newVDR(s)
+		case TypeFSI:
+			return newFSI(s)
+		case TypeSFI:
+			return newSFI(s)
+		case TypeMSK:
+			return newMSK(s)
+		case TypeMSS:
+			return newMSS(s)
+		case TypeMOB:
+			return newMOB(s)
+		case TypeHBT:
+			return newHBT(s)
+		case TypeEVE:
+			return newEVE(s)
+		case TypeNRX:
+			return newNRX(s)
+		case TypeSPW:
+			return newSPW(s)
+		case TypeGFA:
+			return newGFA(s)
+		case TypePOS:
+			return newPOS(s)
+		case TypeRMA:
+			return newRMA(s)
+		case TypeGLC:
+			return newGLC(s)
+		case TypeTRF:
+			return newTRF(s)
+		case TypeSTN:
+			return newSTN(s)
+		case TypeWCV:
+			return newWCV(s)
+		case TypeHTC:
+			return newHTC(s)
+		case TypeHTD:
+			return newHTD(s)
+		case TypeDOR:
+			return newDOR(s)
+		case TypeETL:
+			return newETL(s)
+		case TypePRC:
+			return newPRC(s)
+		case TypeTRC:
+			return newTRC(s)
+		case TypeTRD:
+			return newTRD(s)
+		case TypeABK:
+			return newABK(s)
+		case TypeLR1:
+			return newLR1(s)
+		case TypeLR2:
+			return newLR2(s)
+		case TypeLR3:
+			return newLR3(s)
+		case TypeSSD:
+			return newSSD(s)
+		case TypeVSD:
+			return newVSD(s)
+		case TypeAIR:
+			return newAIR(s)
+		case TypePGRMZ:
+			return newPGRMZ(s)
+		case TypePGRMM:
+			return newPGRMM(s)
+		case TypePGRMT:
+			return newPGRMT(s)
+		case TypePGRMV:
+			return newPGRMV(s)
+		case TypePUBX:
+			return newPUBX(s)
+		case TypePMTK001:
+			return newPMTK001(s)
+		case TypePMTK010:
+			return newPMTK010(s)
+		case TypePSRF150:
+			return newPSRF150(s)
+		case TypePSRF161:
+			return newPSRF161(s)
+		case TypePRDID:
+			return newPRDID(s)
+		case TypePASHR:
+			return newPASHR(s)
+		case TypePHTRO:
+			return newPHTRO(s)
+		case TypePSKPDPT:
+			return newPSKPDPT(s)
+		case TypePMGNST:
+			return newPMGNST(s)
+		case TypePFEC:
+			return newPFEC(s)
+		case TypePTNL:
+			return newPTNL(s)
+		case TypePCDIN:
+			return newPCDIN(s)
+		case TypeSTALK:
+			return newSTALK(s)
+		case TypePKWDWPL:
+			return newPKWDWPL(s)
+		case TypeXDR:
+			return newXDR(s)
 		}
 	}
 	if strings.HasPrefix(s.Raw, SentenceStartEncapsulated) {
 		switch s.Type {
 		case TypeVDM, TypeVDO:
 			return newVDMVDO(s)
+		case TypeABM:
+			return newABM(s)
+		case TypeBBM:
+			return newBBM(s)
 		}
 	}
 	return nil, fmt.Errorf("nmea: sentence prefix '%s' not supported", s.Prefix())
 }
+
+// ParseInto parses the given string into the sentence dst points to, e.g. a *RMC,
+// so that a single value can be reused for every sentence of a fixed rate feed.
+// The destination is reset first. An error is returned if the sentence is not of the
+// destination's type. The most common sentences are decoded without allocating a
+// new Sentence value, and the VDM and VDO payload bits are decoded into the existing
+// Payload buffer of the destination.
+func ParseInto(raw string, dst Sentence) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return fmt.Errorf("nmea: ParseInto destination must be a non-nil pointer, got %T", dst)
+	}
+	s, err := parseSentence(raw)
+	if err != nil {
+		v.Elem().Set(reflect.Zero(v.Elem().Type()))
+		return err
+	}
+	switch d := dst.(type) {
+	case *RMC:
+		*d, err = newRMC(s)
+	case *GGA:
+		*d, err = newGGA(s)
+	case *GSA:
+		*d, err = newGSA(s)
+	case *GLL:
+		*d, err = newGLL(s)
+	case *VTG:
+		*d, err = newVTG(s)
+	case *ZDA:
+		*d, err = newZDA(s)
+	case *GSV:
+		*d, err = newGSV(s)
+	case *GNS:
+		*d, err = newGNS(s)
+	case *HDT:
+		*d, err = newHDT(s)
+	case *THS:
+		*d, err = newTHS(s)
+	case *VDMVDO:
+		if !strings.HasPrefix(s.Raw, SentenceStartEncapsulated) || (s.Type != TypeVDM && s.Type != TypeVDO) {
+			return parseIntoValue(s, v.Elem())
+		}
+		*d, err = appendVDMVDO(s, d.Payload[:0])
+	default:
+		return parseIntoValue(s, v.Elem())
+	}
+	return err
+}
+
+// parseIntoValue is the ParseInto fallback for the sentences without a fast path.
+func parseIntoValue(s BaseSentence, dst reflect.Value) error {
+	dst.Set(reflect.Zero(dst.Type()))
+	m, err := newSentence(s)
+	if m == nil {
+		return err
+	}
+	if reflect.TypeOf(m) != dst.Type() {
+		return &FieldError{Prefix: s.Prefix(), Context: "type", Value: s.Type}
+	}
+	dst.Set(reflect.ValueOf(m))
+	return err
+}
+
+// ParseAll parses the given lines concurrently on the given number of workers,
+// or one per CPU if workers is not positive. The sentences and errors are returned
+// in the order of the lines, with a nil sentence or error where there is none.
+func ParseAll(lines []string, workers int) ([]Sentence, []error) {
+	sentences := make([]Sentence, len(lines))
+	errs := make([]error, len(lines))
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > len(lines) {
+		workers = len(lines)
+	}
+	indexes := make(chan int, workers)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				sentences[i], errs[i] = Parse(lines[i])
+			}
+		}()
+	}
+	for i := range lines {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	return sentences, errs
+}
@@ -1,6 +1,8 @@
 package nmea
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -191,3 +193,161 @@ func TestParse(t *testing.T) {
 		})
 	}
 }
+
+func BenchmarkParseSentence(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = parseSentence("$GNRMC,220516,A,5133.82,N,00042.24,W,173.8,231.8,130694,004.2,W*6E")
+	}
+}
+
+func BenchmarkParse(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = Parse("$GNRMC,220516,A,5133.82,N,00042.24,W,173.8,231.8,130694,004.2,W*6E")
+	}
+}
+
+func TestParseSentenceFieldsAliasRaw(t *testing.T) {
+	raw := "$GNRMC,220516,A,5133.82,N,00042.24,W,173.8,231.8,130694,004.2,W*6E"
+	allocs := testing.AllocsPerRun(100, func() {
+		s, _ := parseSentence(raw)
+		for i := range s.Fields {
+			_ = s.Fields[i]
+		}
+	})
+	// one allocation for the field table
+	assert.True(t, allocs <= 1, "allocations: %v", allocs)
+}
+
+func TestChecksumError(t *testing.T) {
+	raw := "$GPFOO,1,2,3.4,x,y,zz,*51"
+	_, err := Parse(raw)
+	assert.EqualError(t, err, "nmea: sentence checksum mismatch [56 != 51]")
+	assert.True(t, errors.Is(err, ErrChecksum))
+	var checksumErr *ChecksumError
+	assert.True(t, errors.As(err, &checksumErr))
+	assert.Equal(t, &ChecksumError{Computed: "56", Received: "51", Raw: raw}, checksumErr)
+
+	_, err = Parse("$GPFOO,1,2,3.4,x,y,zz,*56")
+	assert.False(t, errors.Is(err, ErrChecksum))
+}
+
+func TestChecksumMatch(t *testing.T) {
+	tests := []struct {
+		checksum uint8
+		raw      string
+		match    bool
+	}{
+		{0x6E, "6E", true},
+		{0x6E, "6e", true},
+		{0x00, "00", true},
+		{0xFF, "fF", true},
+		{0x6E, "6F", false},
+		{0x6E, "6", false},
+		{0x6E, "6E0", false},
+		{0x5A, "5:", false},
+		{0x0F, "0G", false},
+		{0xFF, "\xff\xff", false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.match, checksumMatch(tt.checksum, tt.raw), "%02X %q", tt.checksum, tt.raw)
+	}
+}
+
+func BenchmarkChecksum(b *testing.B) {
+	const body = "GNRMC,220516,A,5133.82,N,00042.24,W,173.8,231.8,130694,004.2,W"
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var sum uint8
+		for j := 0; j < len(body); j++ {
+			sum ^= body[j]
+		}
+		if !checksumMatch(sum, "6E") {
+			b.Fatal("checksum mismatch")
+		}
+	}
+}
+
+func TestParseInto(t *testing.T) {
+	const rmc = "$GNRMC,220516,A,5133.82,N,00042.24,W,173.8,231.8,130694,004.2,W*6E"
+	expected, err := Parse(rmc)
+	assert.NoError(t, err)
+
+	m := RMC{Validity: InvalidRMC, Speed: 1}
+	assert.NoError(t, ParseInto(rmc, &m))
+	assert.Equal(t, expected, m)
+
+	err = ParseInto("$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*51", &m)
+	assert.EqualError(t, err, "nmea: GPGGA invalid type: GGA")
+	assert.Equal(t, Speed(0), m.Speed)
+
+	const xdr = "$IIXDR,A,-1.5,D,PITCH,A,2.3,D,ROLL*3D"
+	expected, err = Parse(xdr)
+	assert.NoError(t, err)
+	x := XDR{Measurements: []XDRMeasurement{{Name: "TempAir"}}}
+	assert.NoError(t, ParseInto(xdr, &x))
+	assert.Equal(t, expected, x)
+
+	err = ParseInto(rmc, &x)
+	assert.EqualError(t, err, "nmea: GNRMC invalid type: RMC")
+	assert.Equal(t, XDR{}, x)
+
+	err = ParseInto("$INVALID,123,123,*7D", &x)
+	assert.EqualError(t, err, "nmea: sentence prefix 'INVALID' not supported")
+
+	m.Speed = 1
+	err = ParseInto("$GNRMC,220516,A,5133.82,N,00042.24,W,173.8,231.8,130694,004.2,W*00", &m)
+	assert.EqualError(t, err, "nmea: sentence checksum mismatch [6E != 00]")
+	assert.Equal(t, RMC{}, m)
+
+	const vdm = "!AIVDM,1,1,,B,13aGra0P00PHid>NK9<2FOvHR624,0*3E"
+	expected, err = Parse(vdm)
+	assert.NoError(t, err)
+	buf := make([]byte, 0, 256)
+	v := VDMVDO{Payload: buf}
+	assert.NoError(t, ParseInto(vdm, &v))
+	assert.Equal(t, expected, v)
+	assert.Equal(t, &buf[:1][0], &v.Payload[0])
+
+	err = ParseInto(rmc, &v)
+	assert.EqualError(t, err, "nmea: GNRMC invalid type: RMC")
+	assert.Equal(t, VDMVDO{}, v)
+
+	err = ParseInto(rmc, m)
+	assert.EqualError(t, err, "nmea: ParseInto destination must be a non-nil pointer, got nmea.RMC")
+	err = ParseInto(rmc, (*RMC)(nil))
+	assert.EqualError(t, err, "nmea: ParseInto destination must be a non-nil pointer, got *nmea.RMC")
+}
+
+func BenchmarkParseInto(b *testing.B) {
+	b.ReportAllocs()
+	var m RMC
+	for i := 0; i < b.N; i++ {
+		_ = ParseInto("$GNRMC,220516,A,5133.82,N,00042.24,W,173.8,231.8,130694,004.2,W*6E", &m)
+	}
+}
+
+func TestParseAll(t *testing.T) {
+	lines := []string{
+		"$GNRMC,220516,A,5133.82,N,00042.24,W,173.8,231.8,130694,004.2,W*6E",
+		"$INVALID,123,123,*7D",
+		"$IIXDR,A,-1.5,D,PITCH,A,2.3,D,ROLL*3D",
+		"$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*51",
+	}
+	for _, workers := range []int{0, 1, 3, 10} {
+		t.Run(fmt.Sprint(workers), func(t *testing.T) {
+			sentences, errs := ParseAll(lines, workers)
+			assert.Len(t, sentences, len(lines))
+			assert.Len(t, errs, len(lines))
+			for i, raw := range lines {
+				m, err := Parse(raw)
+				assert.Equal(t, m, sentences[i])
+				assert.Equal(t, err, errs[i])
+			}
+		})
+	}
+	sentences, errs := ParseAll(nil, 4)
+	assert.Empty(t, sentences)
+	assert.Empty(t, errs)
+}
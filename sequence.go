@@ -0,0 +1,39 @@
+package nmea
+
+import "fmt"
+
+// sequence keeps track of the sentence numbers of a message that spans
+// multiple sentences.
+type sequence struct {
+	total int64
+	next  int64
+}
+
+// add checks that the sentence number continues the sequence and reports
+// whether it was the last sentence of the sequence.
+// A sentence with sentence number 1 always starts a new sequence.
+func (q *sequence) add(typ string, total, number int64) (bool, error) {
+	if number == 1 {
+		q.total = total
+		q.next = 1
+	}
+	if q.next == 0 || number != q.next {
+		q.reset()
+		return false, fmt.Errorf("nmea: %s unexpected sentence number: %d", typ, number)
+	}
+	if total != q.total {
+		q.reset()
+		return false, fmt.Errorf("nmea: %s unexpected number of sentences: %d", typ, total)
+	}
+	q.next++
+	if number < q.total {
+		return false, nil
+	}
+	q.reset()
+	return true, nil
+}
+
+// reset discards the sequence.
+func (q *sequence) reset() {
+	*q = sequence{}
+}
@@ -0,0 +1,72 @@
+package nmea
+
+const (
+	// TypeSFI type for SFI sentences
+	TypeSFI = "SFI"
+)
+
+// SFI is the scanning frequency information, the list of frequencies scanned by a radio.
+// Long lists are sent as a sequence of sentences, see SFIAggregator.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_sfi_scanning_frequency_information
+type SFI struct {
+	BaseSentence
+	TotalMessages int64          // Total number of messages of this type in this cycle
+	MessageNumber int64          // Message number
+	Frequencies   []SFIFrequency // Scanned frequencies (1-6 of these)
+}
+
+// SFIFrequency is a scanned frequency and its mode of operation.
+type SFIFrequency struct {
+	Frequency string // Frequency in 100 Hz units or ITU channel number
+	Mode      string // Mode of operation, as in FSI
+}
+
+// newSFI constructor
+func newSFI(s BaseSentence) (SFI, error) {
+	p := newParser(s)
+	p.AssertType(TypeSFI)
+	m := SFI{
+		BaseSentence:  s,
+		TotalMessages: p.Int64(0, "total number of messages"),
+		MessageNumber: p.Int64(1, "message number"),
+	}
+	for i := 2; i < len(m.Fields); i += 2 {
+		m.Frequencies = append(m.Frequencies, SFIFrequency{
+			Frequency: p.String(i, "frequency"),
+			Mode:      p.String(i+1, "mode"),
+		})
+	}
+	return m, p.Err()
+}
+
+// SFIAggregator concatenates the frequency lists of a sequence of SFI sentences.
+type SFIAggregator struct {
+	frequencies []SFIFrequency
+	seq         sequence
+}
+
+// Add adds the SFI sentence to the list being aggregated. The complete list
+// and true are returned once the last sentence of the sequence has been added.
+// A sentence with message number 1 always starts a new list.
+func (a *SFIAggregator) Add(m SFI) ([]SFIFrequency, bool, error) {
+	if m.MessageNumber == 1 {
+		a.frequencies = nil
+	}
+	done, err := a.seq.add(TypeSFI, m.TotalMessages, m.MessageNumber)
+	if err != nil {
+		a.Reset()
+		return nil, false, err
+	}
+	a.frequencies = append(a.frequencies, m.Frequencies...)
+	if !done {
+		return nil, false, nil
+	}
+	frequencies := a.frequencies
+	a.Reset()
+	return frequencies, true, nil
+}
+
+// Reset discards the list being aggregated.
+func (a *SFIAggregator) Reset() {
+	*a = SFIAggregator{}
+}
@@ -0,0 +1,80 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var sfitests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  SFI
+}{
+	{
+		name: "good sentence",
+		raw:  "$RASFI,2,2,025100,m*24",
+		msg: SFI{
+			TotalMessages: 2,
+			MessageNumber: 2,
+			Frequencies: []SFIFrequency{
+				{Frequency: "025100", Mode: "m"},
+			},
+		},
+	},
+	{
+		name: "missing mode",
+		raw:  "$RASFI,1,1,156725*61",
+		err:  "nmea: RASFI invalid mode: index out of range",
+	},
+	{
+		name: "invalid total number of messages",
+		raw:  "$RASFI,x,1,020230,m*68",
+		err:  "nmea: RASFI invalid total number of messages: x",
+	},
+}
+
+func TestSFI(t *testing.T) {
+	for _, tt := range sfitests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				sfi := m.(SFI)
+				sfi.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, sfi)
+			}
+		})
+	}
+}
+
+func TestSFIAggregator(t *testing.T) {
+	var a SFIAggregator
+	m, err := Parse("$RASFI,2,1,020230,m,026140,m,021500,m,022000,m,023450,m,024000,m*4E")
+	assert.NoError(t, err)
+	_, done, err := a.Add(m.(SFI))
+	assert.NoError(t, err)
+	assert.False(t, done)
+
+	m, err = Parse("$RASFI,2,2,025100,m*24")
+	assert.NoError(t, err)
+	frequencies, done, err := a.Add(m.(SFI))
+	assert.NoError(t, err)
+	assert.True(t, done)
+	assert.Equal(t, []SFIFrequency{
+		{Frequency: "020230", Mode: "m"},
+		{Frequency: "026140", Mode: "m"},
+		{Frequency: "021500", Mode: "m"},
+		{Frequency: "022000", Mode: "m"},
+		{Frequency: "023450", Mode: "m"},
+		{Frequency: "024000", Mode: "m"},
+		{Frequency: "025100", Mode: "m"},
+	}, frequencies)
+
+	_, _, err = a.Add(m.(SFI))
+	assert.EqualError(t, err, "nmea: SFI unexpected sentence number: 2")
+}
@@ -0,0 +1,30 @@
+package nmea
+
+const (
+	// TypeSPW type for SPW sentences
+	TypeSPW = "SPW"
+)
+
+// SPW is the security password sentence. It is sent ahead of a password protected
+// configuration sentence (e.g. SSD, VSD on AIS transponders) to authorise it.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_spw_security_password_sentence
+type SPW struct {
+	BaseSentence
+	ProtectedSentence string // Password protected sentence formatter, e.g. SSD
+	Identifier        string // Unique identifier, e.g. MMSI of the device
+	PasswordLevel     int64  // Password level - 1-user, 2-owner
+	Password          string // Password
+}
+
+// newSPW constructor
+func newSPW(s BaseSentence) (SPW, error) {
+	p := newParser(s)
+	p.AssertType(TypeSPW)
+	return SPW{
+		BaseSentence:      s,
+		ProtectedSentence: p.String(0, "password protected sentence"),
+		Identifier:        p.String(1, "unique identifier"),
+		PasswordLevel:     p.Int64(2, "password level"),
+		Password:          p.String(3, "password"),
+	}, p.Err()
+}
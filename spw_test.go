@@ -0,0 +1,47 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var spwtests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  SPW
+}{
+	{
+		name: "good sentence",
+		raw:  "$AISPW,EPV,211000001,2,SESAME*12",
+		msg: SPW{
+			ProtectedSentence: "EPV",
+			Identifier:        "211000001",
+			PasswordLevel:     2,
+			Password:          "SESAME",
+		},
+	},
+	{
+		name: "invalid password level",
+		raw:  "$AISPW,EPV,211000001,x,SESAME*58",
+		err:  "nmea: AISPW invalid password level: x",
+	},
+}
+
+func TestSPW(t *testing.T) {
+	for _, tt := range spwtests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				spw := m.(SPW)
+				spw.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, spw)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package nmea
+
+const (
+	// TypeSSD type for SSD sentences
+	TypeSSD = "SSD"
+)
+
+// SSD is the AIS ship static data. It is sent to an AIS unit to configure the
+// ship's static data, or by the unit to report it. A null field leaves the
+// configured value unchanged, so each numeric value has a flag telling whether it is set.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_ssd_ais_ship_static_data
+type SSD struct {
+	BaseSentence
+	CallSign string // Ship's call sign
+	Name     string // Ship's name
+	A        int64  // Distance from the position reference point to the bow in meters
+	AValid   bool   // Distance to the bow is set
+	B        int64  // Distance from the position reference point to the stern in meters
+	BValid   bool   // Distance to the stern is set
+	C        int64  // Distance from the position reference point to the port beam in meters
+	CValid   bool   // Distance to the port beam is set
+	D        int64  // Distance from the position reference point to the starboard beam in meters
+	DValid   bool   // Distance to the starboard beam is set
+	DTE      int64  // DTE indicator flag - 0-keyboard and display available, 1-not available
+	DTEValid bool   // DTE indicator flag is set
+	Source   string // Source identifier, talker ID of the position source
+}
+
+// newSSD constructor
+func newSSD(s BaseSentence) (SSD, error) {
+	p := newParser(s)
+	p.AssertType(TypeSSD)
+	return SSD{
+		BaseSentence: s,
+		CallSign:     p.String(0, "call sign"),
+		Name:         p.String(1, "name"),
+		A:            p.Int64(2, "A"),
+		AValid:       p.HasValue(2),
+		B:            p.Int64(3, "B"),
+		BValid:       p.HasValue(3),
+		C:            p.Int64(4, "C"),
+		CValid:       p.HasValue(4),
+		D:            p.Int64(5, "D"),
+		DValid:       p.HasValue(5),
+		DTE:          p.Int64(6, "DTE"),
+		DTEValid:     p.HasValue(6),
+		Source:       p.String(7, "source identifier"),
+	}, p.Err()
+}
+
+// Encode encodes the ship static data into an SSD sentence using the talker of m.
+// Values that are not set are encoded as null fields.
+func (m SSD) Encode() string {
+	return formatSentence(SentenceStart, m.Talker+TypeSSD, []string{
+		m.CallSign,
+		m.Name,
+		formatInt(m.A, m.AValid),
+		formatInt(m.B, m.BValid),
+		formatInt(m.C, m.CValid),
+		formatInt(m.D, m.DValid),
+		formatInt(m.DTE, m.DTEValid),
+		m.Source,
+	})
+}
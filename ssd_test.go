@@ -0,0 +1,65 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var ssdtests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  SSD
+}{
+	{
+		name: "good sentence",
+		raw:  "$AISSD,DABC,EXAMPLE SHIP,150,30,16,16,0,AI*2D",
+		msg: SSD{
+			CallSign: "DABC",
+			Name:     "EXAMPLE SHIP",
+			A:        150,
+			AValid:   true,
+			B:        30,
+			BValid:   true,
+			C:        16,
+			CValid:   true,
+			D:        16,
+			DValid:   true,
+			DTE:      0,
+			DTEValid: true,
+			Source:   "AI",
+		},
+	},
+	{
+		name: "null fields",
+		raw:  "$AISSD,DABC,,,,,,,AI*40",
+		msg: SSD{
+			CallSign: "DABC",
+			Source:   "AI",
+		},
+	},
+	{
+		name: "invalid B",
+		raw:  "$AISSD,DABC,EXAMPLE SHIP,150,x,16,16,0,AI*56",
+		err:  "nmea: AISSD invalid B: x",
+	},
+}
+
+func TestSSD(t *testing.T) {
+	for _, tt := range ssdtests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				ssd := m.(SSD)
+				assert.Equal(t, tt.raw, ssd.Encode())
+				ssd.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, ssd)
+			}
+		})
+	}
+}
@@ -0,0 +1,204 @@
+package nmea
+
+import "fmt"
+
+const (
+	// TypeSTALK type for STALK sentences, split into the ST talker and the ALK type
+	TypeSTALK = "ALK"
+	// DatagramDepthSTALK depth below transducer datagram
+	DatagramDepthSTALK = 0x00
+	// DatagramWindAngleSTALK apparent wind angle datagram
+	DatagramWindAngleSTALK = 0x10
+	// DatagramWindSpeedSTALK apparent wind speed datagram
+	DatagramWindSpeedSTALK = 0x11
+	// DatagramSpeedSTALK speed through water datagram
+	DatagramSpeedSTALK = 0x20
+	// DatagramAutopilotSTALK compass heading, autopilot course and rudder position datagram
+	DatagramAutopilotSTALK = 0x84
+	// DatagramHeadingSTALK compass heading and rudder position datagram
+	DatagramHeadingSTALK = 0x9C
+	// MetersPerSecondSTALK value indicates a wind speed in meters per second
+	MetersPerSecondSTALK = "M"
+	// StandbySTALK autopilot standby mode
+	StandbySTALK = "standby"
+	// AutoSTALK autopilot auto mode
+	AutoSTALK = "auto"
+	// VaneSTALK autopilot vane mode
+	VaneSTALK = "vane"
+	// TrackSTALK autopilot track mode
+	TrackSTALK = "track"
+)
+
+// STALK is a raw SeaTalk1 datagram encapsulated in hex (Raymarine proprietary sentence)
+// http://www.thomasknauf.de/seatalk.htm
+type STALK struct {
+	BaseSentence
+	Command  int64  // Datagram command byte
+	Datagram []byte // Datagram bytes, including the command and attribute bytes
+}
+
+// newSTALK constructor
+func newSTALK(s BaseSentence) (STALK, error) {
+	p := newParser(s)
+	p.AssertType(TypeSTALK)
+	m := STALK{
+		BaseSentence: s,
+		Datagram:     make([]byte, 0, len(s.Fields)),
+	}
+	for i, f := range m.Fields {
+		if len(f) != 2 {
+			p.SetErr("datagram", f)
+			return m, p.Err()
+		}
+		m.Datagram = append(m.Datagram, byte(p.HexInt64(i, "datagram")))
+	}
+	if p.Err() != nil {
+		return m, p.Err()
+	}
+	if len(m.Datagram) < 2 || len(m.Datagram) != int(m.Datagram[1]&0x0F)+3 {
+		p.SetErr("datagram length", fmt.Sprint(len(m.Datagram)))
+		return m, p.Err()
+	}
+	m.Command = int64(m.Datagram[0])
+	return m, p.Err()
+}
+
+// STALKDepth is the decoded depth below transducer datagram 00.
+type STALKDepth struct {
+	Depth               float64 // Depth below the transducer in feet
+	AnchorAlarm         bool    // Anchor alarm is active
+	DeepAlarm           bool    // Deep water alarm is active
+	ShallowAlarm        bool    // Shallow water alarm is active
+	TransducerDefective bool    // Transducer is defective
+}
+
+// Depth decodes a depth below transducer datagram.
+func (m STALK) Depth() (STALKDepth, error) {
+	if err := m.check(DatagramDepthSTALK, 5); err != nil {
+		return STALKDepth{}, err
+	}
+	return STALKDepth{
+		Depth:               float64(m.word(3)) / 10,
+		AnchorAlarm:         m.Datagram[2]&0x80 != 0,
+		TransducerDefective: m.Datagram[2]&0x04 != 0,
+		DeepAlarm:           m.Datagram[2]&0x02 != 0,
+		ShallowAlarm:        m.Datagram[2]&0x01 != 0,
+	}, nil
+}
+
+// WindAngle decodes an apparent wind angle datagram into degrees right of bow.
+func (m STALK) WindAngle() (float64, error) {
+	if err := m.check(DatagramWindAngleSTALK, 4); err != nil {
+		return 0, err
+	}
+	return float64(int(m.Datagram[2])<<8|int(m.Datagram[3])) / 2, nil
+}
+
+// STALKWindSpeed is the decoded apparent wind speed datagram 11.
+type STALKWindSpeed struct {
+	Speed float64 // Apparent wind speed
+	Unit  string  // Speed unit - N-knots, M-meters per second
+}
+
+// WindSpeed decodes an apparent wind speed datagram.
+func (m STALK) WindSpeed() (STALKWindSpeed, error) {
+	if err := m.check(DatagramWindSpeedSTALK, 4); err != nil {
+		return STALKWindSpeed{}, err
+	}
+	w := STALKWindSpeed{
+		Speed: float64(m.Datagram[2]&0x7F) + float64(m.Datagram[3]&0x0F)/10,
+		Unit:  SpeedKnots,
+	}
+	if m.Datagram[2]&0x80 != 0 {
+		w.Unit = MetersPerSecondSTALK
+	}
+	return w, nil
+}
+
+// Speed decodes a speed through water datagram into knots.
+func (m STALK) Speed() (float64, error) {
+	if err := m.check(DatagramSpeedSTALK, 4); err != nil {
+		return 0, err
+	}
+	return float64(m.word(2)) / 10, nil
+}
+
+// STALKHeading is the decoded compass heading and rudder position datagram 9C.
+type STALKHeading struct {
+	Heading float64 // Magnetic compass heading in degrees
+	Rudder  float64 // Rudder position in degrees, positive to starboard
+}
+
+// Heading decodes a compass heading and rudder position datagram.
+func (m STALK) Heading() (STALKHeading, error) {
+	if err := m.check(DatagramHeadingSTALK, 4); err != nil {
+		return STALKHeading{}, err
+	}
+	return STALKHeading{
+		Heading: m.heading(),
+		Rudder:  float64(int8(m.Datagram[3])),
+	}, nil
+}
+
+// STALKAutopilot is the decoded compass heading, autopilot course and rudder position datagram 84.
+type STALKAutopilot struct {
+	Heading float64 // Magnetic compass heading in degrees
+	Course  float64 // Autopilot course in degrees
+	Mode    string  // Autopilot mode - standby, auto, vane or track
+	Rudder  float64 // Rudder position in degrees, positive to starboard
+}
+
+// Autopilot decodes a compass heading, autopilot course and rudder position datagram.
+func (m STALK) Autopilot() (STALKAutopilot, error) {
+	if err := m.check(DatagramAutopilotSTALK, 9); err != nil {
+		return STALKAutopilot{}, err
+	}
+	a := STALKAutopilot{
+		Heading: m.heading(),
+		Course:  float64(m.Datagram[2]>>6)*90 + float64(m.Datagram[3])/2,
+		Rudder:  float64(int8(m.Datagram[6])),
+	}
+	switch m.Datagram[4] & 0x0F {
+	case 0x00:
+		a.Mode = StandbySTALK
+	case 0x02:
+		a.Mode = AutoSTALK
+	case 0x04:
+		a.Mode = VaneSTALK
+	case 0x08:
+		a.Mode = TrackSTALK
+	default:
+		return a, fmt.Errorf("nmea: STALK invalid autopilot mode: %X", m.Datagram[4])
+	}
+	return a, nil
+}
+
+// check verifies the datagram carries the given command with at least size bytes.
+func (m STALK) check(command int64, size int) error {
+	if m.Command != command {
+		return fmt.Errorf("nmea: STALK unexpected datagram: %02X", m.Command)
+	}
+	if len(m.Datagram) < size {
+		return fmt.Errorf("nmea: STALK datagram %02X too short: %d bytes", m.Command, len(m.Datagram))
+	}
+	return nil
+}
+
+// word returns the little endian 16 bit value at index i of the datagram.
+func (m STALK) word(i int) int {
+	return int(m.Datagram[i+1])<<8 | int(m.Datagram[i])
+}
+
+// heading returns the compass heading encoded in the U and VW nibbles
+// shared by the heading and autopilot datagrams.
+func (m STALK) heading() float64 {
+	u := m.Datagram[1] >> 4
+	h := float64(u&0x03)*90 + float64(m.Datagram[2]&0x3F)*2
+	switch u & 0x0C {
+	case 0x04, 0x08:
+		h++
+	case 0x0C:
+		h += 2
+	}
+	return h
+}
@@ -0,0 +1,105 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var stalktests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  STALK
+}{
+	{
+		name: "good sentence",
+		raw:  "$STALK,00,02,00,E8,03*11",
+		msg: STALK{
+			Command:  0x00,
+			Datagram: []byte{0x00, 0x02, 0x00, 0xE8, 0x03},
+		},
+	},
+	{
+		name: "invalid datagram",
+		raw:  "$STALK,00,02,00,ZZ,03*6C",
+		err:  "nmea: STALK invalid datagram: ZZ",
+	},
+	{
+		name: "datagram value longer than a byte",
+		raw:  "$STALK,00,02,00,1A3,03*2F",
+		err:  "nmea: STALK invalid datagram: 1A3",
+	},
+	{
+		name: "datagram value shorter than a byte",
+		raw:  "$STALK,00,02,00,E,03*29",
+		err:  "nmea: STALK invalid datagram: E",
+	},
+	{
+		name: "invalid datagram length",
+		raw:  "$STALK,00,02,00,E8*3E",
+		err:  "nmea: STALK invalid datagram length: 4",
+	},
+}
+
+func TestSTALK(t *testing.T) {
+	for _, tt := range stalktests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				stalk := m.(STALK)
+				stalk.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, stalk)
+			}
+		})
+	}
+}
+
+func TestSTALKDecode(t *testing.T) {
+	m, err := Parse("$STALK,00,02,00,E8,03*11")
+	assert.NoError(t, err)
+	depth, err := m.(STALK).Depth()
+	assert.NoError(t, err)
+	assert.Equal(t, STALKDepth{Depth: 100}, depth)
+	_, err = m.(STALK).Speed()
+	assert.EqualError(t, err, "nmea: STALK unexpected datagram: 00")
+
+	m, err = Parse("$STALK,10,01,00,5A*35")
+	assert.NoError(t, err)
+	angle, err := m.(STALK).WindAngle()
+	assert.NoError(t, err)
+	assert.Equal(t, 45.0, angle)
+
+	m, err = Parse("$STALK,11,01,0C,05*36")
+	assert.NoError(t, err)
+	wind, err := m.(STALK).WindSpeed()
+	assert.NoError(t, err)
+	assert.Equal(t, STALKWindSpeed{Speed: 12.5, Unit: SpeedKnots}, wind)
+
+	m, err = Parse("$STALK,20,01,78,00*4D")
+	assert.NoError(t, err)
+	speed, err := m.(STALK).Speed()
+	assert.NoError(t, err)
+	assert.Equal(t, 12.0, speed)
+
+	m, err = Parse("$STALK,9C,C1,26,FB*49")
+	assert.NoError(t, err)
+	heading, err := m.(STALK).Heading()
+	assert.NoError(t, err)
+	assert.Equal(t, STALKHeading{Heading: 78, Rudder: -5}, heading)
+
+	m, err = Parse("$STALK,84,86,26,97,02,00,00,00,08*6F")
+	assert.NoError(t, err)
+	autopilot, err := m.(STALK).Autopilot()
+	assert.NoError(t, err)
+	assert.Equal(t, STALKAutopilot{Heading: 77, Course: 75.5, Mode: AutoSTALK}, autopilot)
+
+	m, err = Parse("$STALK,84,86,26,97,03,00,00,00,08*6E")
+	assert.NoError(t, err)
+	_, err = m.(STALK).Autopilot()
+	assert.EqualError(t, err, "nmea: STALK invalid autopilot mode: 3")
+}
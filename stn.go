@@ -0,0 +1,49 @@
+package nmea
+
+const (
+	// TypeSTN type for STN sentences
+	TypeSTN = "STN"
+)
+
+// STN is the multiple data ID sentence. It is transmitted before each group of
+// sentences and identifies which of several talkers with the same talker ID
+// produced them.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_stn_multiple_data_id
+type STN struct {
+	BaseSentence
+	Number int64 // Talker ID number, 00 - 99
+}
+
+// newSTN constructor
+func newSTN(s BaseSentence) (STN, error) {
+	p := newParser(s)
+	p.AssertType(TypeSTN)
+	return STN{
+		BaseSentence: s,
+		Number:       p.Int64(0, "talker ID number"),
+	}, p.Err()
+}
+
+// StationTracker keeps track of the talker ID number announced by the STN
+// sentences on a stream, so the sentences following an STN can be attributed
+// to the talker that produced them.
+type StationTracker struct {
+	number int64
+	known  bool
+}
+
+// Station returns the talker ID number of the given sentence. An STN sentence
+// sets the number for itself and all the sentences that follow it. The returned
+// bool is false until the first STN sentence has been seen.
+func (t *StationTracker) Station(s Sentence) (int64, bool) {
+	if m, ok := s.(STN); ok {
+		t.number = m.Number
+		t.known = true
+	}
+	return t.number, t.known
+}
+
+// Reset forgets the last announced talker ID number.
+func (t *StationTracker) Reset() {
+	*t = StationTracker{}
+}
@@ -0,0 +1,65 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var stntests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  STN
+}{
+	{
+		name: "good sentence",
+		raw:  "$GPSTN,01*73",
+		msg: STN{
+			Number: 1,
+		},
+	},
+	{
+		name: "invalid talker ID number",
+		raw:  "$GPSTN,x1*3B",
+		err:  "nmea: GPSTN invalid talker ID number: x1",
+	},
+}
+
+func TestSTN(t *testing.T) {
+	for _, tt := range stntests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				stn := m.(STN)
+				stn.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, stn)
+			}
+		})
+	}
+}
+
+func TestStationTracker(t *testing.T) {
+	var tr StationTracker
+	gga, err := Parse("$GPGGA,034225.077,3356.4650,S,15124.5567,E,1,03,9.7,-25.0,M,21.0,M,,0000*51")
+	assert.NoError(t, err)
+	_, ok := tr.Station(gga)
+	assert.False(t, ok)
+
+	stn, err := Parse("$GPSTN,01*73")
+	assert.NoError(t, err)
+	n, ok := tr.Station(stn)
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), n)
+	n, ok = tr.Station(gga)
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), n)
+
+	tr.Reset()
+	_, ok = tr.Station(gga)
+	assert.False(t, ok)
+}
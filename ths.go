@@ -23,14 +23,20 @@ type THS struct {
 	Status  string  // Heading status
 }
 
+// thsLayout is the field layout of THS sentences.
+var thsLayout = newLayout(THS{}, TypeTHS, []int{2},
+	layoutField{name: "Heading", context: "heading", kind: floatField},
+	layoutField{name: "Status", context: "status", kind: enumField, options: []string{AutonomousTHS, EstimatedTHS, ManualTHS, SimulatorTHS, InvalidTHS}},
+)
+
 // newTHS constructor
 func newTHS(s BaseSentence) (THS, error) {
-	p := newParser(s)
-	p.AssertType(TypeTHS)
-	m := THS{
-		BaseSentence: s,
-		Heading:      p.Float64(0, "heading"),
-		Status:       p.EnumString(1, "status", AutonomousTHS, EstimatedTHS, ManualTHS, SimulatorTHS, InvalidTHS),
-	}
-	return m, p.Err()
+	var m THS
+	err := thsLayout.parse(s, &m)
+	return m, err
+}
+
+// Encode encodes m into a THS sentence using the talker of m.
+func (m THS) Encode() string {
+	return thsLayout.encode(m.Talker, m)
 }
@@ -0,0 +1,40 @@
+package nmea
+
+const (
+	// TypeTLL type for TLL sentences
+	TypeTLL = "TLL"
+)
+
+// TLL is the target latitude and longitude, sent by radars to report the position of a target.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_tll_target_latitude_and_longitude
+type TLL struct {
+	BaseSentence
+	TargetNumber    int64     // Target number, 00 - 99
+	Latitude        Latitude  // Target latitude
+	Longitude       Longitude // Target longitude
+	TargetName      string    // Target name
+	Time            Time      // UTC time of data
+	TargetStatus    string    // Target status - L-lost, Q-query, T-tracking
+	ReferenceTarget bool      // Target is the reference target
+}
+
+// newTLL constructor
+func newTLL(s BaseSentence) (TLL, error) {
+	p := newParser(s)
+	p.AssertType(TypeTLL)
+	return TLL{
+		BaseSentence:    s,
+		TargetNumber:    p.Int64(0, "target number"),
+		Latitude:        p.Latitude(1, 2, "latitude"),
+		Longitude:       p.Longitude(3, 4, "longitude"),
+		TargetName:      p.String(5, "target name"),
+		Time:            p.Time(6, "time"),
+		TargetStatus:    p.EnumString(7, "target status", LostTTM, QueryTTM, TrackingTTM),
+		ReferenceTarget: p.EnumString(8, "reference target", "R") == "R",
+	}, p.Err()
+}
+
+// Position returns the reported position.
+func (m TLL) Position() Position {
+	return Position{Latitude: m.Latitude, Longitude: m.Longitude}
+}
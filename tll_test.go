@@ -0,0 +1,54 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var tlltests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  TLL
+}{
+	{
+		name: "good sentence",
+		raw:  "$RATLL,01,4916.87,N,12307.85,W,TGT01,100021.00,T,*72",
+		msg: TLL{
+			TargetNumber: 1,
+			Latitude:     Latitude(MustParseGPS("4916.87 N")),
+			Longitude:    Longitude(MustParseGPS("12307.85 W")),
+			TargetName:   "TGT01",
+			Time:         Time{true, 10, 0, 21, 0, 0},
+			TargetStatus: TrackingTTM,
+		},
+	},
+	{
+		name: "invalid target number",
+		raw:  "$RATLL,x1,4916.87,N,12307.85,W,TGT01,100021.00,T,*3A",
+		err:  "nmea: RATLL invalid target number: x1",
+	},
+	{
+		name: "invalid target status",
+		raw:  "$RATLL,01,4916.87,N,12307.85,W,TGT01,100021.00,X,*7E",
+		err:  "nmea: RATLL invalid target status: X",
+	},
+}
+
+func TestTLL(t *testing.T) {
+	for _, tt := range tlltests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				tll := m.(TLL)
+				tll.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, tll)
+			}
+		})
+	}
+}
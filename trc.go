@@ -0,0 +1,46 @@
+package nmea
+
+const (
+	// TypeTRC type for TRC sentences
+	TypeTRC = "TRC"
+	// StatusReportTRC sentence status flag character, the sentence is a status report
+	StatusReportTRC = "R"
+	// StatusConfigurationTRC sentence status flag character, the sentence is a configuration command
+	StatusConfigurationTRC = "C"
+)
+
+// TRC is the thruster control data. The RPM and pitch modes use the same
+// characters as PRC and the operating location the same characters as ETL.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_trc_thruster_control_data
+type TRC struct {
+	BaseSentence
+	Number            int64   // Thruster number
+	RPMDemand         float64 // RPM demand value
+	RPMMode           string  // RPM mode - P-percent, R-revolutions per minute, V-invalid
+	PitchDemand       float64 // Pitch demand value
+	PitchMode         string  // Pitch mode - P-percent, D-degrees, V-invalid
+	AzimuthDemand     float64 // Azimuth demand in degrees, 0 - 359.9
+	OperatingLocation string  // Operating location - B-bridge, P-port wing, S-starboard wing, C-engine control room, E-engine side, W-wing
+	SentenceStatus    string  // Sentence status flag - R-status report, C-configuration command
+}
+
+// newTRC constructor
+func newTRC(s BaseSentence) (TRC, error) {
+	p := newParser(s)
+	p.AssertType(TypeTRC)
+	p.AssertFieldCount(7, 8)
+	m := TRC{
+		BaseSentence:      s,
+		Number:            p.Int64(0, "thruster number"),
+		RPMDemand:         p.Float64(1, "RPM demand"),
+		RPMMode:           p.EnumString(2, "RPM mode", PercentPRC, RevolutionsPRC, InvalidPRC),
+		PitchDemand:       p.Float64(3, "pitch demand"),
+		PitchMode:         p.EnumString(4, "pitch mode", PercentPRC, DegreesPRC, InvalidPRC),
+		AzimuthDemand:     p.Float64(5, "azimuth demand"),
+		OperatingLocation: p.EnumString(6, "operating location", BridgeETL, PortWingETL, StarboardWingETL, EngineControlRoomETL, EngineSideETL, WingETL),
+	}
+	if len(m.Fields) > 7 {
+		m.SentenceStatus = p.EnumString(7, "sentence status", StatusReportTRC, StatusConfigurationTRC)
+	}
+	return m, p.Err()
+}
@@ -0,0 +1,50 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var trctests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  TRC
+}{
+	{
+		name: "good sentence",
+		raw:  "$ERTRC,1,80.0,P,,V,135.0,B,C*5B",
+		msg: TRC{
+			Number:            1,
+			RPMDemand:         80,
+			RPMMode:           PercentPRC,
+			PitchMode:         InvalidPRC,
+			AzimuthDemand:     135,
+			OperatingLocation: BridgeETL,
+			SentenceStatus:    StatusConfigurationTRC,
+		},
+	},
+	{
+		name: "invalid pitch mode",
+		raw:  "$ERTRC,1,80.0,P,10.0,X,135.0,B*25",
+		err:  "nmea: ERTRC invalid pitch mode: X",
+	},
+}
+
+func TestTRC(t *testing.T) {
+	for _, tt := range trctests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				trc := m.(TRC)
+				trc.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, trc)
+			}
+		})
+	}
+}
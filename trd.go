@@ -0,0 +1,34 @@
+package nmea
+
+const (
+	// TypeTRD type for TRD sentences
+	TypeTRD = "TRD"
+)
+
+// TRD is the thruster response data. The RPM and pitch modes use the same
+// characters as PRC.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_trd_thruster_response_data
+type TRD struct {
+	BaseSentence
+	Number          int64   // Thruster number
+	RPMResponse     float64 // RPM response value
+	RPMMode         string  // RPM mode - P-percent, R-revolutions per minute, V-invalid
+	PitchResponse   float64 // Pitch response value
+	PitchMode       string  // Pitch mode - P-percent, D-degrees, V-invalid
+	AzimuthResponse float64 // Azimuth response in degrees, 0 - 359.9
+}
+
+// newTRD constructor
+func newTRD(s BaseSentence) (TRD, error) {
+	p := newParser(s)
+	p.AssertType(TypeTRD)
+	return TRD{
+		BaseSentence:    s,
+		Number:          p.Int64(0, "thruster number"),
+		RPMResponse:     p.Float64(1, "RPM response"),
+		RPMMode:         p.EnumString(2, "RPM mode", PercentPRC, RevolutionsPRC, InvalidPRC),
+		PitchResponse:   p.Float64(3, "pitch response"),
+		PitchMode:       p.EnumString(4, "pitch mode", PercentPRC, DegreesPRC, InvalidPRC),
+		AzimuthResponse: p.Float64(5, "azimuth response"),
+	}, p.Err()
+}
@@ -0,0 +1,48 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var trdtests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  TRD
+}{
+	{
+		name: "good sentence",
+		raw:  "$ERTRD,1,78.5,P,,V,133.2*5B",
+		msg: TRD{
+			Number:          1,
+			RPMResponse:     78.5,
+			RPMMode:         PercentPRC,
+			PitchMode:       InvalidPRC,
+			AzimuthResponse: 133.2,
+		},
+	},
+	{
+		name: "invalid thruster number",
+		raw:  "$ERTRD,x,78.5,P,,V,133.2*12",
+		err:  "nmea: ERTRD invalid thruster number: x",
+	},
+}
+
+func TestTRD(t *testing.T) {
+	for _, tt := range trdtests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				trd := m.(TRD)
+				trd.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, trd)
+			}
+		})
+	}
+}
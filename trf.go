@@ -0,0 +1,51 @@
+package nmea
+
+const (
+	// TypeTRF type for TRF sentences
+	TypeTRF = "TRF"
+	// ValidTRF character
+	ValidTRF = "A"
+	// InvalidTRF character
+	InvalidTRF = "V"
+)
+
+// TRF is the TRANSIT satellite fix data. The TRANSIT system is obsolete, the
+// sentence is supported for historical log files.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_trf_transit_fix_data
+type TRF struct {
+	BaseSentence
+	Time             Time      // UTC time of the fix
+	Date             Date      // UTC date of the fix
+	Latitude         Latitude  // Latitude
+	Longitude        Longitude // Longitude
+	ElevationAngle   float64   // Elevation angle in degrees
+	Iterations       int64     // Number of iterations
+	DopplerIntervals int64     // Number of doppler intervals
+	UpdateDistance   float64   // Update distance in nautical miles
+	SatelliteID      int64     // Satellite ID
+	Validity         string    // validity - A-ok, V-invalid
+}
+
+// newTRF constructor
+func newTRF(s BaseSentence) (TRF, error) {
+	p := newParser(s)
+	p.AssertType(TypeTRF)
+	return TRF{
+		BaseSentence:     s,
+		Time:             p.Time(0, "time"),
+		Date:             p.Date(1, "date"),
+		Latitude:         p.Latitude(2, 3, "latitude"),
+		Longitude:        p.Longitude(4, 5, "longitude"),
+		ElevationAngle:   p.Float64(6, "elevation angle"),
+		Iterations:       p.Int64(7, "number of iterations"),
+		DopplerIntervals: p.Int64(8, "number of doppler intervals"),
+		UpdateDistance:   p.Float64(9, "update distance"),
+		SatelliteID:      p.Int64(10, "satellite ID"),
+		Validity:         p.EnumString(11, "validity", ValidTRF, InvalidTRF),
+	}, p.Err()
+}
+
+// Position returns the reported position.
+func (m TRF) Position() Position {
+	return Position{Latitude: m.Latitude, Longitude: m.Longitude}
+}
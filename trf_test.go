@@ -0,0 +1,58 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var trftests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  TRF
+}{
+	{
+		name: "good sentence",
+		raw:  "$TRTRF,123519.00,230394,4807.038,N,01131.000,E,45.2,4,12,2.5,112,A*23",
+		msg: TRF{
+			Time:             Time{true, 12, 35, 19, 0, 0},
+			Date:             Date{true, 23, 3, 94},
+			Latitude:         Latitude(MustParseGPS("4807.038 N")),
+			Longitude:        Longitude(MustParseGPS("01131.000 E")),
+			ElevationAngle:   45.2,
+			Iterations:       4,
+			DopplerIntervals: 12,
+			UpdateDistance:   2.5,
+			SatelliteID:      112,
+			Validity:         ValidTRF,
+		},
+	},
+	{
+		name: "invalid validity",
+		raw:  "$TRTRF,123519.00,230394,4807.038,N,01131.000,E,45.2,4,12,2.5,112,X*3A",
+		err:  "nmea: TRTRF invalid validity: X",
+	},
+	{
+		name: "invalid number of iterations",
+		raw:  "$TRTRF,123519.00,230394,4807.038,N,01131.000,E,45.2,x,12,2.5,112,A*6F",
+		err:  "nmea: TRTRF invalid number of iterations: x",
+	},
+}
+
+func TestTRF(t *testing.T) {
+	for _, tt := range trftests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				trf := m.(TRF)
+				trf.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, trf)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package nmea
+
+const (
+	// TypeTTM type for TTM sentences
+	TypeTTM = "TTM"
+	// LostTTM target status character, the target is lost
+	LostTTM = "L"
+	// QueryTTM target status character, the target is being acquired
+	QueryTTM = "Q"
+	// TrackingTTM target status character, the target is being tracked
+	TrackingTTM = "T"
+	// AutomaticTTM type of acquisition character
+	AutomaticTTM = "A"
+	// ManualTTM type of acquisition character
+	ManualTTM = "M"
+	// ReportedTTM type of acquisition character
+	ReportedTTM = "R"
+)
+
+// TTM is the tracked target message, sent by radars to report a target.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_ttm_tracked_target_message
+type TTM struct {
+	BaseSentence
+	TargetNumber    int64   // Target number, 00 - 99
+	TargetDistance  float64 // Target distance from own ship
+	Bearing         float64 // Bearing from own ship in degrees
+	BearingType     string  // Bearing type, T for true or R for relative
+	TargetSpeed     float64 // Target speed
+	TargetCourse    float64 // Target course in degrees
+	CourseType      string  // Course type, T for true or R for relative
+	CPADistance     float64 // Distance of closest point of approach
+	TCPA            float64 // Time to closest point of approach in minutes, negative when moving away
+	Units           string  // Speed and distance units, K, N or S
+	TargetName      string  // Target name
+	TargetStatus    string  // Target status - L-lost, Q-query, T-tracking
+	ReferenceTarget bool    // Target is the reference target
+	Time            Time    // UTC time of data
+	Acquisition     string  // Type of acquisition - A-automatic, M-manual, R-reported
+}
+
+// newTTM constructor
+func newTTM(s BaseSentence) (TTM, error) {
+	p := newParser(s)
+	p.AssertType(TypeTTM)
+	p.AssertFieldCount(13, 15)
+	m := TTM{
+		BaseSentence:    s,
+		TargetNumber:    p.Int64(0, "target number"),
+		TargetDistance:  p.Float64(1, "target distance"),
+		Bearing:         p.Float64(2, "bearing"),
+		BearingType:     p.EnumString(3, "bearing type", BearingTrue, BearingRelative),
+		TargetSpeed:     p.Float64(4, "target speed"),
+		TargetCourse:    p.Float64(5, "target course"),
+		CourseType:      p.EnumString(6, "course type", BearingTrue, BearingRelative),
+		CPADistance:     p.Float64(7, "CPA distance"),
+		TCPA:            p.Float64(8, "TCPA"),
+		Units:           p.EnumString(9, "units", DistanceKilometers, DistanceNauticalMiles, DistanceStatuteMiles),
+		TargetName:      p.String(10, "target name"),
+		TargetStatus:    p.EnumString(11, "target status", LostTTM, QueryTTM, TrackingTTM),
+		ReferenceTarget: p.EnumString(12, "reference target", "R") == "R",
+	}
+	if len(m.Fields) > 13 {
+		m.Time = p.Time(13, "time")
+		m.Acquisition = p.EnumString(14, "acquisition", AutomaticTTM, ManualTTM, ReportedTTM)
+	}
+	return m, p.Err()
+}
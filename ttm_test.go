@@ -0,0 +1,87 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var ttmtests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  TTM
+}{
+	{
+		name: "good sentence",
+		raw:  "$RATTM,02,1.43,170.5,T,0.16,264.4,T,1.42,36.9,N,,T,,100021.00,A*0A",
+		msg: TTM{
+			TargetNumber:   2,
+			TargetDistance: 1.43,
+			Bearing:        170.5,
+			BearingType:    BearingTrue,
+			TargetSpeed:    0.16,
+			TargetCourse:   264.4,
+			CourseType:     BearingTrue,
+			CPADistance:    1.42,
+			TCPA:           36.9,
+			Units:          DistanceNauticalMiles,
+			TargetStatus:   TrackingTTM,
+			Time:           Time{true, 10, 0, 21, 0, 0},
+			Acquisition:    AutomaticTTM,
+		},
+	},
+	{
+		name: "good sentence without time and acquisition",
+		raw:  "$RATTM,01,0.72,359.2,R,0.00,0.0,T,0.72,0.0,N,TGT01,Q,R*43",
+		msg: TTM{
+			TargetNumber:    1,
+			TargetDistance:  0.72,
+			Bearing:         359.2,
+			BearingType:     BearingRelative,
+			CourseType:      BearingTrue,
+			CPADistance:     0.72,
+			Units:           DistanceNauticalMiles,
+			TargetName:      "TGT01",
+			TargetStatus:    QueryTTM,
+			ReferenceTarget: true,
+		},
+	},
+	{
+		name: "invalid bearing type",
+		raw:  "$RATTM,02,1.43,170.5,X,0.16,264.4,T,1.42,36.9,N,,T,,100021.00,A*06",
+		err:  "nmea: RATTM invalid bearing type: X",
+	},
+	{
+		name: "invalid units",
+		raw:  "$RATTM,02,1.43,170.5,T,0.16,264.4,T,1.42,36.9,X,,T,,100021.00,A*1C",
+		err:  "nmea: RATTM invalid units: X",
+	},
+	{
+		name: "invalid target status",
+		raw:  "$RATTM,02,1.43,170.5,T,0.16,264.4,T,1.42,36.9,N,,X,,100021.00,A*06",
+		err:  "nmea: RATTM invalid target status: X",
+	},
+	{
+		name: "invalid acquisition",
+		raw:  "$RATTM,02,1.43,170.5,T,0.16,264.4,T,1.42,36.9,N,,T,,100021.00,X*13",
+		err:  "nmea: RATTM invalid acquisition: X",
+	},
+}
+
+func TestTTM(t *testing.T) {
+	for _, tt := range ttmtests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				ttm := m.(TTM)
+				ttm.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, ttm)
+			}
+		})
+	}
+}
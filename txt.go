@@ -0,0 +1,30 @@
+package nmea
+
+const (
+	// TypeTXT type for TXT sentences
+	TypeTXT = "TXT"
+)
+
+// TXT is a short text message, commonly used by receivers to report startup and error information.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_txt_text_transmission
+type TXT struct {
+	BaseSentence
+	TotalNumber int64  // Total number of sentences, 01 to 99
+	Number      int64  // Sentence number, 01 to 99
+	ID          int64  // Text identifier, 01 to 99
+	Message     string // Text message
+}
+
+// newTXT constructor
+func newTXT(s BaseSentence) (TXT, error) {
+	p := newParser(s)
+	p.AssertType(TypeTXT)
+	m := TXT{
+		BaseSentence: s,
+		TotalNumber:  p.Int64(0, "total number of sentences"),
+		Number:       p.Int64(1, "sentence number"),
+		ID:           p.Int64(2, "text identifier"),
+		Message:      p.Text(3, "message"),
+	}
+	return m, p.Err()
+}
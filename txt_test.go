@@ -0,0 +1,77 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var txttests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  TXT
+}{
+	{
+		name: "good sentence",
+		raw:  "$GPTXT,01,01,02,u-blox ag - www.u-blox.com*50",
+		msg: TXT{
+			TotalNumber: 1,
+			Number:      1,
+			ID:          2,
+			Message:     "u-blox ag - www.u-blox.com",
+		},
+	},
+	{
+		name: "escaped characters",
+		raw:  "$GPTXT,01,01,02,a^2Cb^5Ec*2C",
+		msg: TXT{
+			TotalNumber: 1,
+			Number:      1,
+			ID:          2,
+			Message:     "a,b^c",
+		},
+	},
+	{
+		name: "unescaped comma",
+		raw:  "$GPTXT,01,01,02,a,b*62",
+		msg: TXT{
+			TotalNumber: 1,
+			Number:      1,
+			ID:          2,
+			Message:     "a,b",
+		},
+	},
+	{
+		name: "invalid escape",
+		raw:  "$GPTXT,01,01,02,a^2Xb*7A",
+		err:  "nmea: GPTXT invalid message: a^2Xb",
+	},
+	{
+		name: "truncated escape",
+		raw:  "$GPTXT,01,01,02,abc^2*41",
+		err:  "nmea: GPTXT invalid message: abc^2",
+	},
+	{
+		name: "invalid text identifier",
+		raw:  "$GPTXT,01,01,x2,a*64",
+		err:  "nmea: GPTXT invalid text identifier: x2",
+	},
+}
+
+func TestTXT(t *testing.T) {
+	for _, tt := range txttests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				txt := m.(TXT)
+				txt.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, txt)
+			}
+		})
+	}
+}
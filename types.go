@@ -9,6 +9,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 	"unicode"
 )
 
@@ -29,6 +30,50 @@ const (
 	East = "E"
 	// West value
 	West = "W"
+	// Left value
+	Left = "L"
+	// Right value
+	Right = "R"
+	// BearingTrue value indicates a bearing relative to true north
+	BearingTrue = "T"
+	// BearingMagnetic value indicates a bearing relative to magnetic north
+	BearingMagnetic = "M"
+	// BearingRelative value indicates a bearing relative to the vessel heading
+	BearingRelative = "R"
+	// DistanceNauticalMiles value indicates a distance in nautical miles
+	DistanceNauticalMiles = "N"
+	// DistanceKilometers value indicates a distance in kilometers
+	DistanceKilometers = "K"
+	// DistanceStatuteMiles value indicates a distance in statute miles
+	DistanceStatuteMiles = "S"
+	// SpeedKnots value indicates a speed in knots
+	SpeedKnots = "N"
+	// FAAModeAutonomous autonomous mode indicator
+	FAAModeAutonomous = "A"
+	// FAAModeDifferential differential mode indicator
+	FAAModeDifferential = "D"
+	// FAAModeEstimated estimated (dead reckoning) mode indicator
+	FAAModeEstimated = "E"
+	// FAAModeManual manual input mode indicator
+	FAAModeManual = "M"
+	// FAAModeSimulator simulator mode indicator
+	FAAModeSimulator = "S"
+	// FAAModeNotValid data not valid mode indicator
+	FAAModeNotValid = "N"
+	// FAAModePrecise precise mode indicator
+	FAAModePrecise = "P"
+)
+
+// LatLongFormat is the notation a coordinate string is written in.
+type LatLongFormat string
+
+const (
+	// DMSFormat degrees, minutes, seconds notation (e.g. 33° 23' 22")
+	DMSFormat LatLongFormat = "DMS"
+	// GPSFormat GPS/NMEA degrees and decimal minutes notation (e.g 15113.4322 S)
+	GPSFormat LatLongFormat = "GPS"
+	// DecimalFormat decimal degrees notation (e.g. 33.23454)
+	DecimalFormat LatLongFormat = "decimal"
 )
 
 // ParseLatLong parses the supplied string into the LatLong.
@@ -39,26 +84,38 @@ const (
 // - GPS (e.g 15113.4322S)
 //
 func ParseLatLong(s string) (float64, error) {
+	l, _, err := ParseLatLongFormat(s)
+	return l, err
+}
+
+// ParseLatLongFormat parses the supplied string into the LatLong
+// like ParseLatLong and also reports which format matched.
+func ParseLatLongFormat(s string) (float64, LatLongFormat, error) {
 	var l float64
+	var format LatLongFormat
 	if v, err := ParseDMS(s); err == nil {
-		l = v
+		l, format = v, DMSFormat
 	} else if v, err := ParseGPS(s); err == nil {
-		l = v
+		l, format = v, GPSFormat
 	} else if v, err := ParseDecimal(s); err == nil {
-		l = v
+		l, format = v, DecimalFormat
 	} else {
-		return 0, fmt.Errorf("cannot parse [%s], unknown format", s)
+		return 0, "", fmt.Errorf("cannot parse [%s], unknown format", s)
 	}
 	if l < -180.0 || 180.0 < l {
-		return 0, errors.New("coordinate is not in range -180, 180")
+		return 0, "", errors.New("coordinate is not in range -180, 180")
 	}
-	return l, nil
+	return l, format, nil
 }
 
 // ParseGPS parses a GPS/NMEA coordinate.
-// e.g 15113.4322S
+// The direction may be separated from the value by a space.
+// e.g 15113.4322S or 15113.4322 S
 func ParseGPS(s string) (float64, error) {
 	parts := strings.Split(s, " ")
+	if len(parts) == 1 && len(s) > 1 {
+		parts = []string{s[:len(s)-1], s[len(s)-1:]}
+	}
 	if len(parts) != 2 {
 		return 0, fmt.Errorf("invalid format: %s", s)
 	}
@@ -165,18 +222,222 @@ func FormatDMS(l float64) string {
 	return fmt.Sprintf("%d\u00B0 %d' %f\"", degrees, minutes, seconds)
 }
 
+// Latitude in decimal degrees, positive to the north.
+type Latitude float64
+
+// Decimal returns the latitude in decimal degrees.
+func (l Latitude) Decimal() float64 {
+	return float64(l)
+}
+
+// Hemisphere returns the hemisphere of the latitude, N or S.
+func (l Latitude) Hemisphere() string {
+	if l < 0 {
+		return South
+	}
+	return North
+}
+
+// DMS returns the latitude in degrees, minutes, seconds.
+// e.g. 51\u00B0 33' 49.200000" N
+func (l Latitude) DMS() string {
+	return FormatDMS(float64(l)) + " " + l.Hemisphere()
+}
+
+// DM returns the latitude in degrees and decimal minutes.
+// e.g. 51\u00B0 33.8200' N
+func (l Latitude) DM() string {
+	degrees, minutes := splitDM(float64(l))
+	return fmt.Sprintf("%d\u00B0 %.4f' %s", degrees, minutes, l.Hemisphere())
+}
+
+// NMEA returns the latitude and hemisphere fields as they appear in a sentence.
+// e.g. 5133.8200,N
+func (l Latitude) NMEA() string {
+	degrees, minutes := splitDM(float64(l))
+	return fmt.Sprintf("%02d%07.4f,%s", degrees, minutes, l.Hemisphere())
+}
+
+// String representation of Latitude
+func (l Latitude) String() string {
+	return l.DMS()
+}
+
+// Longitude in decimal degrees, positive to the east.
+type Longitude float64
+
+// Decimal returns the longitude in decimal degrees.
+func (l Longitude) Decimal() float64 {
+	return float64(l)
+}
+
+// Hemisphere returns the hemisphere of the longitude, E or W.
+func (l Longitude) Hemisphere() string {
+	if l < 0 {
+		return West
+	}
+	return East
+}
+
+// DMS returns the longitude in degrees, minutes, seconds.
+// e.g. 0\u00B0 42' 14.400000" W
+func (l Longitude) DMS() string {
+	return FormatDMS(float64(l)) + " " + l.Hemisphere()
+}
+
+// DM returns the longitude in degrees and decimal minutes.
+// e.g. 0\u00B0 42.2400' W
+func (l Longitude) DM() string {
+	degrees, minutes := splitDM(float64(l))
+	return fmt.Sprintf("%d\u00B0 %.4f' %s", degrees, minutes, l.Hemisphere())
+}
+
+// NMEA returns the longitude and hemisphere fields as they appear in a sentence.
+// e.g. 00042.2400,W
+func (l Longitude) NMEA() string {
+	degrees, minutes := splitDM(float64(l))
+	return fmt.Sprintf("%03d%07.4f,%s", degrees, minutes, l.Hemisphere())
+}
+
+// String representation of Longitude
+func (l Longitude) String() string {
+	return l.DMS()
+}
+
+// splitDM splits the absolute value of a coordinate into whole degrees
+// and minutes rounded to four decimals.
+func splitDM(l float64) (int, float64) {
+	val := math.Abs(l)
+	degrees := math.Floor(val)
+	minutes := round((val-degrees)*60*10000) / 10000
+	if minutes >= 60 {
+		degrees++
+		minutes -= 60
+	}
+	return int(degrees), minutes
+}
+
+// Speed conversion factors from knots
+const (
+	knotsToKilometersPerHour = 1.852
+	knotsToMetersPerSecond   = 1852.0 / 3600
+	knotsToMilesPerHour      = 1.852 / 1.609344
+)
+
+// Speed in knots, the unit used by most sentences.
+type Speed float64
+
+// SpeedFromKnots returns the Speed for a value in knots.
+func SpeedFromKnots(v float64) Speed {
+	return Speed(v)
+}
+
+// SpeedFromKilometersPerHour returns the Speed for a value in kilometers per hour.
+func SpeedFromKilometersPerHour(v float64) Speed {
+	return Speed(v / knotsToKilometersPerHour)
+}
+
+// SpeedFromMetersPerSecond returns the Speed for a value in meters per second.
+func SpeedFromMetersPerSecond(v float64) Speed {
+	return Speed(v / knotsToMetersPerSecond)
+}
+
+// SpeedFromMilesPerHour returns the Speed for a value in statute miles per hour.
+func SpeedFromMilesPerHour(v float64) Speed {
+	return Speed(v / knotsToMilesPerHour)
+}
+
+// Knots returns the speed in knots.
+func (s Speed) Knots() float64 {
+	return float64(s)
+}
+
+// KilometersPerHour returns the speed in kilometers per hour.
+func (s Speed) KilometersPerHour() float64 {
+	return float64(s) * knotsToKilometersPerHour
+}
+
+// MetersPerSecond returns the speed in meters per second.
+func (s Speed) MetersPerSecond() float64 {
+	return float64(s) * knotsToMetersPerSecond
+}
+
+// MilesPerHour returns the speed in statute miles per hour.
+func (s Speed) MilesPerHour() float64 {
+	return float64(s) * knotsToMilesPerHour
+}
+
+// Distance conversion factors from meters
+const (
+	metersPerFoot         = 0.3048
+	metersPerFathom       = 1.8288
+	metersPerNauticalMile = 1852.0
+)
+
+// Distance in meters, the canonical unit for depths and ranges.
+type Distance float64
+
+// DistanceFromMeters returns the Distance for a value in meters.
+func DistanceFromMeters(v float64) Distance {
+	return Distance(v)
+}
+
+// DistanceFromFeet returns the Distance for a value in feet.
+func DistanceFromFeet(v float64) Distance {
+	return Distance(v * metersPerFoot)
+}
+
+// DistanceFromFathoms returns the Distance for a value in fathoms.
+func DistanceFromFathoms(v float64) Distance {
+	return Distance(v * metersPerFathom)
+}
+
+// DistanceFromNauticalMiles returns the Distance for a value in nautical miles.
+func DistanceFromNauticalMiles(v float64) Distance {
+	return Distance(v * metersPerNauticalMile)
+}
+
+// Meters returns the distance in meters.
+func (d Distance) Meters() float64 {
+	return float64(d)
+}
+
+// Feet returns the distance in feet.
+func (d Distance) Feet() float64 {
+	return float64(d) / metersPerFoot
+}
+
+// Fathoms returns the distance in fathoms.
+func (d Distance) Fathoms() float64 {
+	return float64(d) / metersPerFathom
+}
+
+// NauticalMiles returns the distance in nautical miles.
+func (d Distance) NauticalMiles() float64 {
+	return float64(d) / metersPerNauticalMile
+}
+
 // Time type
+// The fraction of the second is Millisecond plus SubMillisecond nanoseconds, so a
+// Time with only Millisecond set keeps its meaning. SubMillisecond was added after
+// Millisecond, so unkeyed Time literals need the extra value.
 type Time struct {
-	Valid       bool
-	Hour        int
-	Minute      int
-	Second      int
-	Millisecond int
+	Valid          bool
+	Hour           int
+	Minute         int
+	Second         int
+	Millisecond    int // Milliseconds of the fraction of the second, 0 - 999
+	SubMillisecond int // Remaining nanoseconds of the fraction of the second, 0 - 999999
+}
+
+// nanoseconds returns the fraction of the second in nanoseconds.
+func (t Time) nanoseconds() int {
+	return t.Millisecond*int(time.Millisecond) + t.SubMillisecond
 }
 
 // String representation of Time
 func (t Time) String() string {
-	seconds := float64(t.Second) + float64(t.Millisecond)/1000
+	seconds := float64(t.Second) + float64(t.nanoseconds())/1e9
 	return fmt.Sprintf("%02d:%02d:%07.4f", t.Hour, t.Minute, seconds)
 }
 
@@ -195,9 +456,31 @@ func ParseTime(s string) (Time, error) {
 	}
 	hour, _ := strconv.Atoi(s[:2])
 	minute, _ := strconv.Atoi(s[2:4])
-	second, _ := strconv.ParseFloat(s[4:], 64)
-	whole, frac := math.Modf(second)
-	return Time{true, hour, minute, int(whole), int(round(frac * 1000))}, nil
+	second, _ := strconv.Atoi(s[4:6])
+	nanosecond := 0
+	if len(s) > 7 {
+		digits := s[7:]
+		if len(digits) > 9 {
+			digits = digits[:9]
+		}
+		nanosecond, _ = strconv.Atoi(digits + strings.Repeat("0", 9-len(digits)))
+	}
+	ms := int(time.Millisecond)
+	return Time{true, hour, minute, second, nanosecond / ms, nanosecond % ms}, nil
+}
+
+// ParseDuration parses an elapsed time.
+// e.g. hhmmss.ss
+// An empty duration string will result in a zero duration.
+func ParseDuration(s string) (time.Duration, error) {
+	t, err := ParseTime(s)
+	if err != nil {
+		return 0, fmt.Errorf("parse duration: expected hhmmss.ss format, got '%s'", s)
+	}
+	return time.Duration(t.Hour)*time.Hour +
+		time.Duration(t.Minute)*time.Minute +
+		time.Duration(t.Second)*time.Second +
+		time.Duration(t.nanoseconds()), nil
 }
 
 // round is implemented here because it wasn't added until go1.10
@@ -246,3 +529,217 @@ func ParseDate(ddmmyy string) (Date, error) {
 	}
 	return Date{true, dd, mm, yy}, nil
 }
+
+// yearPivot is the two digit year below which years are in the 21st century.
+const yearPivot = 70
+
+// DateTime combines the UTC date and time into a time.Time in the given location.
+// Two digit years below 70 are in the 21st century, the rest in the 20th.
+// A nil location returns the time in UTC. If the date or time is not valid,
+// the zero time is returned.
+func DateTime(loc *time.Location, d Date, t Time) time.Time {
+	if !d.Valid || !t.Valid {
+		return time.Time{}
+	}
+	year := d.YY
+	if year < 100 {
+		if year < yearPivot {
+			year += 2000
+		} else {
+			year += 1900
+		}
+	}
+	v := time.Date(year, time.Month(d.MM), d.DD, t.Hour, t.Minute, t.Second, t.nanoseconds(), time.UTC)
+	if loc != nil {
+		v = v.In(loc)
+	}
+	return v
+}
+
+// GPSWeekRolloverPeriod is the period after which the 10 bit GPS week number wraps around.
+const GPSWeekRolloverPeriod = 1024 * 7 * 24 * time.Hour
+
+// CorrectWeekRollover corrects a time reported by a receiver affected by GPS week
+// number rollover, which shows up as a date a multiple of 1024 weeks in the past.
+// The time is moved forward by whole rollover periods until it is no earlier than
+// notBefore, the earliest plausible time (e.g. the build date of the application).
+// It reports whether a correction was applied. The zero time is returned unchanged.
+func CorrectWeekRollover(t, notBefore time.Time) (time.Time, bool) {
+	if t.IsZero() || !t.Before(notBefore) {
+		return t, false
+	}
+	periods := notBefore.Sub(t) / GPSWeekRolloverPeriod
+	if t.Add(periods * GPSWeekRolloverPeriod).Before(notBefore) {
+		periods++
+	}
+	return t.Add(periods * GPSWeekRolloverPeriod), true
+}
+
+// TrueFromMagnetic converts a magnetic heading or course in degrees to true, given the
+// magnetic variation in degrees, positive to the east and negative to the west.
+func TrueFromMagnetic(heading, variation float64) float64 {
+	return normalizeDegrees(heading + variation)
+}
+
+// MagneticFromTrue converts a true heading or course in degrees to magnetic, given the
+// magnetic variation in degrees, positive to the east and negative to the west.
+func MagneticFromTrue(heading, variation float64) float64 {
+	return normalizeDegrees(heading - variation)
+}
+
+// normalizeDegrees returns the angle in degrees in the range [0, 360).
+func normalizeDegrees(v float64) float64 {
+	v = math.Mod(v, 360)
+	if v < 0 {
+		v += 360
+	}
+	// A tiny negative remainder rounds up to 360 when added
+	if v >= 360 {
+		v -= 360
+	}
+	return v
+}
+
+// positionTolerance is the difference in degrees under which coordinates are equal, about 1 cm.
+const positionTolerance = 1e-7
+
+// Position is a geographic position.
+type Position struct {
+	Latitude  Latitude
+	Longitude Longitude
+}
+
+// Positioner is implemented by the sentences that report a position.
+type Positioner interface {
+	Position() Position
+}
+
+// Valid reports whether the coordinates are in range. The zero position
+// is not valid, since that is what sentences with empty coordinates report.
+func (p Position) Valid() bool {
+	if p.Latitude == 0 && p.Longitude == 0 {
+		return false
+	}
+	return -90 <= p.Latitude && p.Latitude <= 90 && -180 <= p.Longitude && p.Longitude <= 180
+}
+
+// Equal reports whether both positions are the same, within about a centimeter.
+func (p Position) Equal(o Position) bool {
+	return math.Abs(float64(p.Latitude-o.Latitude)) < positionTolerance &&
+		math.Abs(float64(p.Longitude-o.Longitude)) < positionTolerance
+}
+
+// String representation of Position
+func (p Position) String() string {
+	return p.Latitude.DM() + ", " + p.Longitude.DM()
+}
+
+// Bearing is a heading, course or bearing in degrees, in the range [0, 360).
+type Bearing float64
+
+// NewBearing returns the Bearing for an angle in degrees, normalized to the range [0, 360).
+func NewBearing(v float64) Bearing {
+	return Bearing(normalizeDegrees(v))
+}
+
+// Degrees returns the bearing in degrees.
+func (b Bearing) Degrees() float64 {
+	return float64(b)
+}
+
+// Add returns the bearing turned by the given degrees, positive clockwise.
+func (b Bearing) Add(degrees float64) Bearing {
+	return NewBearing(float64(b) + degrees)
+}
+
+// Difference returns the smallest signed angle in degrees to turn from b to o,
+// in the range (-180, 180], positive clockwise.
+func (b Bearing) Difference(o Bearing) float64 {
+	d := normalizeDegrees(float64(o - b))
+	if d > 180 {
+		d -= 360
+	}
+	return d
+}
+
+// Interpolate returns the bearing at fraction f of the shortest turn from b to o.
+func (b Bearing) Interpolate(o Bearing, f float64) Bearing {
+	return b.Add(b.Difference(o) * f)
+}
+
+// Temperature in degrees Celsius, the unit used by most sentences.
+type Temperature float64
+
+// TemperatureFromCelsius returns the Temperature for a value in degrees Celsius.
+func TemperatureFromCelsius(v float64) Temperature {
+	return Temperature(v)
+}
+
+// TemperatureFromFahrenheit returns the Temperature for a value in degrees Fahrenheit.
+func TemperatureFromFahrenheit(v float64) Temperature {
+	return Temperature((v - 32) * 5 / 9)
+}
+
+// Celsius returns the temperature in degrees Celsius.
+func (t Temperature) Celsius() float64 {
+	return float64(t)
+}
+
+// Fahrenheit returns the temperature in degrees Fahrenheit.
+func (t Temperature) Fahrenheit() float64 {
+	return float64(t)*9/5 + 32
+}
+
+// String representation of Temperature
+func (t Temperature) String() string {
+	return fmt.Sprintf("%.1f°C", t.Celsius())
+}
+
+// Pressure conversion factors from bars
+const (
+	pascalsPerBar         = 100000
+	pascalsPerInchMercury = 3386.389
+)
+
+// Pressure in bars, the unit used by most sentences.
+type Pressure float64
+
+// PressureFromBars returns the Pressure for a value in bars.
+func PressureFromBars(v float64) Pressure {
+	return Pressure(v)
+}
+
+// PressureFromPascals returns the Pressure for a value in pascals.
+func PressureFromPascals(v float64) Pressure {
+	return Pressure(v / pascalsPerBar)
+}
+
+// PressureFromHectopascals returns the Pressure for a value in hectopascals or millibars.
+func PressureFromHectopascals(v float64) Pressure {
+	return Pressure(v * 100 / pascalsPerBar)
+}
+
+// PressureFromInchesOfMercury returns the Pressure for a value in inches of mercury.
+func PressureFromInchesOfMercury(v float64) Pressure {
+	return Pressure(v * pascalsPerInchMercury / pascalsPerBar)
+}
+
+// Bars returns the pressure in bars.
+func (p Pressure) Bars() float64 {
+	return float64(p)
+}
+
+// Pascals returns the pressure in pascals.
+func (p Pressure) Pascals() float64 {
+	return float64(p) * pascalsPerBar
+}
+
+// Hectopascals returns the pressure in hectopascals, the same as millibars.
+func (p Pressure) Hectopascals() float64 {
+	return float64(p) * pascalsPerBar / 100
+}
+
+// InchesOfMercury returns the pressure in inches of mercury.
+func (p Pressure) InchesOfMercury() float64 {
+	return float64(p) * pascalsPerBar / pascalsPerInchMercury
+}
@@ -3,6 +3,7 @@ package nmea
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -32,6 +33,35 @@ func TestParseLatLong(t *testing.T) {
 	}
 }
 
+func TestParseLatLongFormat(t *testing.T) {
+	var tests = []struct {
+		value    string
+		expected float64
+		format   LatLongFormat
+		err      bool
+	}{
+		{"33\u00B0 12' 34.3423\"", 33.209540, DMSFormat, false},
+		{"3345.1232 N", 33.752054, GPSFormat, false},
+		{"15113.4322S", -151.22387, GPSFormat, false},
+		{"151.234532", 151.234532, DecimalFormat, false},
+		{"-33.752054", -33.752054, DecimalFormat, false},
+		{"200.000", 0, "", true},
+		{"x12.3", 0, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			l, format, err := ParseLatLongFormat(tt.value)
+			if tt.err {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.InDelta(t, tt.expected, l, nearDistance)
+			}
+			assert.Equal(t, tt.format, format)
+		})
+	}
+}
+
 func TestParseGPS(t *testing.T) {
 	var tests = []struct {
 		value    string
@@ -40,6 +70,7 @@ func TestParseGPS(t *testing.T) {
 	}{
 		{"3345.1232 N", 33.752054, false},
 		{"15145.9877 S", -151.76646, false},
+		{"15145.9877S", -151.76646, false},
 		{"12345.1234 X", 0, true},
 		{"1234.1234", 0, true},
 	}
@@ -132,16 +163,97 @@ func TestLatLongPrint(t *testing.T) {
 	}
 }
 
+func TestLatitudeFormat(t *testing.T) {
+	var tests = []struct {
+		value      Latitude
+		hemisphere string
+		dms        string
+		dm         string
+		nmea       string
+	}{
+		{
+			value:      51.56366666666667,
+			hemisphere: North,
+			dms:        "51° 33' 49.200000\" N",
+			dm:         "51° 33.8200' N",
+			nmea:       "5133.8200,N",
+		},
+		{
+			value:      -33.94057166666666,
+			hemisphere: South,
+			dms:        "33° 56' 26.058000\" S",
+			dm:         "33° 56.4343' S",
+			nmea:       "3356.4343,S",
+		},
+		{
+			value:      44.99999999,
+			hemisphere: North,
+			dms:        "44° 59' 59.999964\" N",
+			dm:         "45° 0.0000' N",
+			nmea:       "4500.0000,N",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%f", tt.value), func(t *testing.T) {
+			assert.Equal(t, float64(tt.value), tt.value.Decimal())
+			assert.Equal(t, tt.hemisphere, tt.value.Hemisphere())
+			assert.Equal(t, tt.dms, tt.value.DMS())
+			assert.Equal(t, tt.dms, tt.value.String())
+			assert.Equal(t, tt.dm, tt.value.DM())
+			assert.Equal(t, tt.nmea, tt.value.NMEA())
+		})
+	}
+}
+
+func TestLongitudeFormat(t *testing.T) {
+	var tests = []struct {
+		value      Longitude
+		hemisphere string
+		dms        string
+		dm         string
+		nmea       string
+	}{
+		{
+			value:      -0.704,
+			hemisphere: West,
+			dms:        "0° 42' 14.400000\" W",
+			dm:         "0° 42.2400' W",
+			nmea:       "00042.2400,W",
+		},
+		{
+			value:      151.434367,
+			hemisphere: East,
+			dms:        "151° 26' 3.721200\" E",
+			dm:         "151° 26.0620' E",
+			nmea:       "15126.0620,E",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%f", tt.value), func(t *testing.T) {
+			assert.Equal(t, float64(tt.value), tt.value.Decimal())
+			assert.Equal(t, tt.hemisphere, tt.value.Hemisphere())
+			assert.Equal(t, tt.dms, tt.value.DMS())
+			assert.Equal(t, tt.dms, tt.value.String())
+			assert.Equal(t, tt.dm, tt.value.DM())
+			assert.Equal(t, tt.nmea, tt.value.NMEA())
+		})
+	}
+}
+
 func TestTimeParse(t *testing.T) {
 	timetests := []struct {
 		value    string
 		expected Time
 		ok       bool
 	}{
-		{"123456", Time{true, 12, 34, 56, 0}, true},
+		{"123456", Time{true, 12, 34, 56, 0, 0}, true},
 		{"", Time{}, true},
-		{"112233.123", Time{true, 11, 22, 33, 123}, true},
-		{"010203.04", Time{true, 1, 2, 3, 40}, true},
+		{"112233.123", Time{true, 11, 22, 33, 123, 0}, true},
+		{"010203.04", Time{true, 1, 2, 3, 40, 0}, true},
+		{"112233.123456", Time{true, 11, 22, 33, 123, 456000}, true},
+		{"112233.123456789", Time{true, 11, 22, 33, 123, 456789}, true},
+		{"112233.1234567891", Time{true, 11, 22, 33, 123, 456789}, true},
+		{"112233.9999", Time{true, 11, 22, 33, 999, 900000}, true},
 		{"10203.04", Time{}, false},
 		{"x0u2xd", Time{}, false},
 		{"xx2233.123", Time{}, false},
@@ -166,6 +278,141 @@ func TestTimeParse(t *testing.T) {
 	}
 }
 
+func TestSpeed(t *testing.T) {
+	var tests = []struct {
+		name  string
+		speed Speed
+		knots float64
+		kph   float64
+		mps   float64
+		mph   float64
+	}{
+		{
+			name:  "knots",
+			speed: SpeedFromKnots(10),
+			knots: 10,
+			kph:   18.52,
+			mps:   5.144444,
+			mph:   11.507794,
+		},
+		{
+			name:  "kilometers per hour",
+			speed: SpeedFromKilometersPerHour(18.52),
+			knots: 10,
+			kph:   18.52,
+			mps:   5.144444,
+			mph:   11.507794,
+		},
+		{
+			name:  "meters per second",
+			speed: SpeedFromMetersPerSecond(1),
+			knots: 1.943844,
+			kph:   3.6,
+			mps:   1,
+			mph:   2.236936,
+		},
+		{
+			name:  "miles per hour",
+			speed: SpeedFromMilesPerHour(60),
+			knots: 52.138575,
+			kph:   96.56064,
+			mps:   26.8224,
+			mph:   60,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.knots, tt.speed.Knots(), 1e-6)
+			assert.InDelta(t, tt.kph, tt.speed.KilometersPerHour(), 1e-6)
+			assert.InDelta(t, tt.mps, tt.speed.MetersPerSecond(), 1e-6)
+			assert.InDelta(t, tt.mph, tt.speed.MilesPerHour(), 1e-6)
+		})
+	}
+}
+
+func TestDistance(t *testing.T) {
+	var tests = []struct {
+		name          string
+		distance      Distance
+		meters        float64
+		feet          float64
+		fathoms       float64
+		nauticalMiles float64
+	}{
+		{
+			name:          "meters",
+			distance:      DistanceFromMeters(1852),
+			meters:        1852,
+			feet:          6076.115486,
+			fathoms:       1012.685914,
+			nauticalMiles: 1,
+		},
+		{
+			name:          "feet",
+			distance:      DistanceFromFeet(6),
+			meters:        1.8288,
+			feet:          6,
+			fathoms:       1,
+			nauticalMiles: 0.000987,
+		},
+		{
+			name:          "fathoms",
+			distance:      DistanceFromFathoms(2),
+			meters:        3.6576,
+			feet:          12,
+			fathoms:       2,
+			nauticalMiles: 0.001975,
+		},
+		{
+			name:          "nautical miles",
+			distance:      DistanceFromNauticalMiles(0.5),
+			meters:        926,
+			feet:          3038.057743,
+			fathoms:       506.342957,
+			nauticalMiles: 0.5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.meters, tt.distance.Meters(), 1e-6)
+			assert.InDelta(t, tt.feet, tt.distance.Feet(), 1e-6)
+			assert.InDelta(t, tt.fathoms, tt.distance.Fathoms(), 1e-6)
+			assert.InDelta(t, tt.nauticalMiles, tt.distance.NauticalMiles(), 1e-6)
+		})
+	}
+}
+
+func TestDurationParse(t *testing.T) {
+	durationtests := []struct {
+		value    string
+		expected time.Duration
+		ok       bool
+	}{
+		{"123456", 12*time.Hour + 34*time.Minute + 56*time.Second, true},
+		{"", 0, true},
+		{"000033.25", 33*time.Second + 250*time.Millisecond, true},
+		{"000033.250125", 33*time.Second + 250125*time.Microsecond, true},
+		{"990000", 99 * time.Hour, true},
+		{"10203.04", 0, false},
+		{"x0u2xd", 0, false},
+	}
+	for _, tt := range durationtests {
+		actual, err := ParseDuration(tt.value)
+		if !tt.ok {
+			if err == nil {
+				t.Errorf("ParseDuration(%s) expected error", tt.value)
+			}
+		} else {
+			if err != nil {
+				t.Errorf("ParseDuration(%s) %s", tt.value, err)
+			}
+			if actual != tt.expected {
+				t.Errorf("ParseDuration(%s) got %s expected %s", tt.value, actual, tt.expected)
+			}
+		}
+	}
+}
+
 func TestTimeString(t *testing.T) {
 	d := Time{
 		Hour:        1,
@@ -179,6 +426,22 @@ func TestTimeString(t *testing.T) {
 	}
 }
 
+func TestTimeSubMillisecond(t *testing.T) {
+	d := Time{Valid: true, Hour: 1, Minute: 2, Second: 3, Millisecond: 4, SubMillisecond: 500000}
+	assert.Equal(t, "01:02:03.0045", d.String())
+	date := Date{true, 13, 6, 24}
+	assert.Equal(t, time.Date(2024, time.June, 13, 1, 2, 3, 4500000, time.UTC), DateTime(nil, date, d))
+
+	// A Time built with only Millisecond set keeps its fraction.
+	d = Time{Valid: true, Hour: 1, Minute: 2, Second: 3, Millisecond: 4}
+	assert.Equal(t, "01:02:03.0040", d.String())
+	assert.Equal(t, time.Date(2024, time.June, 13, 1, 2, 3, 4000000, time.UTC), DateTime(nil, date, d))
+
+	// The fields add up, an out of range SubMillisecond is not settled against Millisecond.
+	d = Time{Valid: true, Hour: 1, Minute: 2, Second: 3, Millisecond: 4, SubMillisecond: 2000000}
+	assert.Equal(t, "01:02:03.0060", d.String())
+}
+
 func TestDateParse(t *testing.T) {
 	datetests := []struct {
 		value    string
@@ -220,3 +483,271 @@ func TestDateString(t *testing.T) {
 		t.Fatalf("got %s expected %s", s, expected)
 	}
 }
+
+func TestDateTime(t *testing.T) {
+	var tests = []struct {
+		name     string
+		date     Date
+		time     Time
+		expected time.Time
+	}{
+		{
+			name:     "21st century",
+			date:     Date{true, 13, 6, 24},
+			time:     Time{true, 22, 5, 16, 250, 0},
+			expected: time.Date(2024, time.June, 13, 22, 5, 16, 250*int(time.Millisecond), time.UTC),
+		},
+		{
+			name:     "20th century",
+			date:     Date{true, 13, 6, 94},
+			time:     Time{true, 22, 5, 16, 0, 0},
+			expected: time.Date(1994, time.June, 13, 22, 5, 16, 0, time.UTC),
+		},
+		{
+			name:     "pivot year",
+			date:     Date{true, 1, 1, 70},
+			time:     Time{true, 0, 0, 0, 0, 0},
+			expected: time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "millisecond only",
+			date:     Date{true, 13, 6, 24},
+			time:     Time{Valid: true, Hour: 22, Minute: 5, Second: 16, Millisecond: 250},
+			expected: time.Date(2024, time.June, 13, 22, 5, 16, 250*int(time.Millisecond), time.UTC),
+		},
+		{
+			name: "invalid date",
+			date: Date{},
+			time: Time{true, 22, 5, 16, 0, 0},
+		},
+		{
+			name: "invalid time",
+			date: Date{true, 13, 6, 94},
+			time: Time{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, DateTime(nil, tt.date, tt.time))
+		})
+	}
+}
+
+func TestCorrectWeekRollover(t *testing.T) {
+	notBefore := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	var tests = []struct {
+		name      string
+		value     time.Time
+		expected  time.Time
+		corrected bool
+	}{
+		{
+			name:     "plausible time",
+			value:    time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC),
+			expected: time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "one rollover",
+			value:     time.Date(2005, time.July, 16, 12, 0, 0, 0, time.UTC),
+			expected:  time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC),
+			corrected: true,
+		},
+		{
+			name:      "two rollovers",
+			value:     time.Date(1985, time.November, 30, 12, 0, 0, 0, time.UTC),
+			expected:  time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC),
+			corrected: true,
+		},
+		{
+			name: "zero time",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, corrected := CorrectWeekRollover(tt.value, notBefore)
+			assert.Equal(t, tt.expected, v)
+			assert.Equal(t, tt.corrected, corrected)
+		})
+	}
+}
+
+func TestMagneticVariation(t *testing.T) {
+	var tests = []struct {
+		name      string
+		magnetic  float64
+		variation float64
+		trueValue float64
+	}{
+		{name: "east", magnetic: 100, variation: 5.5, trueValue: 105.5},
+		{name: "west", magnetic: 100, variation: -4.2, trueValue: 95.8},
+		{name: "wrap past north", magnetic: 358, variation: 4, trueValue: 2},
+		{name: "wrap before north", magnetic: 2, variation: -4, trueValue: 358},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.trueValue, TrueFromMagnetic(tt.magnetic, tt.variation), 1e-9)
+			assert.InDelta(t, tt.magnetic, MagneticFromTrue(tt.trueValue, tt.variation), 1e-9)
+		})
+	}
+}
+
+func TestNormalizeDegrees(t *testing.T) {
+	assert.Equal(t, 0.0, normalizeDegrees(-1e-14))
+	assert.Equal(t, 0.0, normalizeDegrees(360))
+	assert.Equal(t, 359.5, normalizeDegrees(-0.5))
+	assert.Equal(t, Bearing(0), NewBearing(-1e-14))
+	assert.Equal(t, 0.0, TrueFromMagnetic(1e-14, -2e-14))
+}
+
+func TestPosition(t *testing.T) {
+	p := Position{Latitude: 51.56366666666667, Longitude: -0.704}
+	assert.True(t, p.Valid())
+	assert.Equal(t, "51° 33.8200' N, 0° 42.2400' W", p.String())
+	assert.True(t, p.Equal(Position{Latitude: 51.56366667, Longitude: -0.70400001}))
+	assert.False(t, p.Equal(Position{Latitude: 51.5637, Longitude: -0.704}))
+	assert.False(t, Position{}.Valid())
+	assert.False(t, Position{Latitude: 91, Longitude: 10}.Valid())
+	assert.False(t, Position{Latitude: 45, Longitude: -181}.Valid())
+}
+
+func TestPositioner(t *testing.T) {
+	var tests = []struct {
+		raw      string
+		expected Position
+	}{
+		{
+			raw:      "$GNGGA,203415.000,6325.6138,N,01021.4290,E,1,8,2.42,72.5,M,41.5,M,,*7C",
+			expected: Position{Latitude(MustParseGPS("6325.6138 N")), Longitude(MustParseGPS("01021.4290 E"))},
+		},
+		{
+			raw:      "$GNRMC,220516,A,5133.82,N,00042.24,W,173.8,231.8,130694,004.2,W*6E",
+			expected: Position{Latitude(MustParseGPS("5133.82 N")), Longitude(MustParseGPS("00042.24 W"))},
+		},
+		{
+			raw:      "$GPGLL,3926.7952,N,12000.5947,W,022732,A,A*58",
+			expected: Position{Latitude(MustParseGPS("3926.7952 N")), Longitude(MustParseGPS("12000.5947 W"))},
+		},
+		{
+			raw:      "$GNGNS,014035.00,4332.69262,S,17235.48549,E,RR,13,0.9,25.63,11.24,,*70",
+			expected: Position{Latitude(MustParseGPS("4332.69262 S")), Longitude(MustParseGPS("17235.48549 E"))},
+		},
+		{
+			raw:      "$IIWPL,5503.4530,N,01037.2742,E,411*6F",
+			expected: Position{Latitude(MustParseGPS("5503.4530 N")), Longitude(MustParseGPS("01037.2742 E"))},
+		},
+		{
+			raw:      "$RATLL,01,4916.87,N,12307.85,W,TGT01,100021.00,T,*72",
+			expected: Position{Latitude(MustParseGPS("4916.87 N")), Longitude(MustParseGPS("12307.85 W"))},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			assert.NoError(t, err)
+			p, ok := m.(Positioner)
+			assert.True(t, ok)
+			assert.Equal(t, tt.expected, p.Position())
+		})
+	}
+}
+
+func TestBearing(t *testing.T) {
+	assert.Equal(t, Bearing(0), NewBearing(360))
+	assert.Equal(t, Bearing(350), NewBearing(-10))
+	assert.Equal(t, Bearing(10), NewBearing(730))
+	assert.Equal(t, 45.5, Bearing(45.5).Degrees())
+	assert.Equal(t, Bearing(5), Bearing(350).Add(15))
+	assert.Equal(t, Bearing(355), Bearing(5).Add(-10))
+
+	var tests = []struct {
+		name        string
+		from, to    Bearing
+		difference  float64
+		interpolate Bearing
+	}{
+		{name: "clockwise", from: 10, to: 50, difference: 40, interpolate: 30},
+		{name: "counter clockwise", from: 50, to: 10, difference: -40, interpolate: 30},
+		{name: "clockwise through north", from: 350, to: 10, difference: 20, interpolate: 0},
+		{name: "counter clockwise through north", from: 10, to: 330, difference: -40, interpolate: 350},
+		{name: "opposite", from: 0, to: 180, difference: 180, interpolate: 90},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.difference, tt.from.Difference(tt.to), 1e-9)
+			assert.InDelta(t, tt.interpolate.Degrees(), tt.from.Interpolate(tt.to, 0.5).Degrees(), 1e-9)
+		})
+	}
+}
+
+func TestTemperature(t *testing.T) {
+	var tests = []struct {
+		name        string
+		temperature Temperature
+		celsius     float64
+		fahrenheit  float64
+		str         string
+	}{
+		{name: "celsius", temperature: TemperatureFromCelsius(19.5), celsius: 19.5, fahrenheit: 67.1, str: "19.5°C"},
+		{name: "fahrenheit", temperature: TemperatureFromFahrenheit(14), celsius: -10, fahrenheit: 14, str: "-10.0°C"},
+		{name: "freezing", temperature: TemperatureFromFahrenheit(32), celsius: 0, fahrenheit: 32, str: "0.0°C"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.celsius, tt.temperature.Celsius(), 1e-9)
+			assert.InDelta(t, tt.fahrenheit, tt.temperature.Fahrenheit(), 1e-9)
+			assert.Equal(t, tt.str, tt.temperature.String())
+		})
+	}
+}
+
+func TestPressure(t *testing.T) {
+	var tests = []struct {
+		name            string
+		pressure        Pressure
+		bars            float64
+		pascals         float64
+		hectopascals    float64
+		inchesOfMercury float64
+	}{
+		{
+			name:            "bars",
+			pressure:        PressureFromBars(1.01325),
+			bars:            1.01325,
+			pascals:         101325,
+			hectopascals:    1013.25,
+			inchesOfMercury: 29.921252,
+		},
+		{
+			name:            "pascals",
+			pressure:        PressureFromPascals(101325),
+			bars:            1.01325,
+			pascals:         101325,
+			hectopascals:    1013.25,
+			inchesOfMercury: 29.921252,
+		},
+		{
+			name:            "hectopascals",
+			pressure:        PressureFromHectopascals(980),
+			bars:            0.98,
+			pascals:         98000,
+			hectopascals:    980,
+			inchesOfMercury: 28.939381,
+		},
+		{
+			name:            "inches of mercury",
+			pressure:        PressureFromInchesOfMercury(30),
+			bars:            1.01591670,
+			pascals:         101591.67,
+			hectopascals:    1015.9167,
+			inchesOfMercury: 30,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.bars, tt.pressure.Bars(), 1e-6)
+			assert.InDelta(t, tt.pascals, tt.pressure.Pascals(), 1e-6)
+			assert.InDelta(t, tt.hectopascals, tt.pressure.Hectopascals(), 1e-6)
+			assert.InDelta(t, tt.inchesOfMercury, tt.pressure.InchesOfMercury(), 1e-6)
+		})
+	}
+}
@@ -22,6 +22,11 @@ type VDMVDO struct {
 
 // newVDMVDO constructor
 func newVDMVDO(s BaseSentence) (VDMVDO, error) {
+	return appendVDMVDO(s, []byte{})
+}
+
+// appendVDMVDO parses the sentence, appending the payload bits to the given buffer.
+func appendVDMVDO(s BaseSentence, payload []byte) (VDMVDO, error) {
 	p := newParser(s)
 	m := VDMVDO{
 		BaseSentence:   s,
@@ -29,7 +34,10 @@ func newVDMVDO(s BaseSentence) (VDMVDO, error) {
 		FragmentNumber: p.Int64(1, "fragment number"),
 		MessageID:      p.Int64(2, "sequence number"),
 		Channel:        p.String(3, "channel ID"),
-		Payload:        p.SixBitASCIIArmour(4, int(p.Int64(5, "number of padding bits")), "payload"),
+		Payload:        p.SixBitASCIIArmourAppend(payload, 4, int(p.Int64(5, "number of padding bits")), "payload"),
+	}
+	if p.Err() != nil {
+		m.Payload = nil
 	}
 	return m, p.Err()
 }
@@ -0,0 +1,37 @@
+package nmea
+
+const (
+	// TypeVDR type for VDR sentences
+	TypeVDR = "VDR"
+)
+
+// VDR is the set and drift, the direction and speed of the current.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_vdr_set_and_drift
+type VDR struct {
+	BaseSentence
+	SetDegreesTrue     float64 // Direction of the current in degrees true
+	SetDegreesMagnetic float64 // Direction of the current in degrees magnetic
+	DriftKnots         float64 // Speed of the current in knots
+}
+
+// newVDR constructor
+func newVDR(s BaseSentence) (VDR, error) {
+	p := newParser(s)
+	p.AssertType(TypeVDR)
+
+	setTrue := p.Float64(0, "true set")
+	_ = p.EnumString(1, "true set unit", BearingTrue)
+
+	setMagnetic := p.Float64(2, "magnetic set")
+	_ = p.EnumString(3, "magnetic set unit", BearingMagnetic)
+
+	drift := p.Float64(4, "drift")
+	_ = p.EnumString(5, "drift unit", SpeedKnots)
+
+	return VDR{
+		BaseSentence:       s,
+		SetDegreesTrue:     setTrue,
+		SetDegreesMagnetic: setMagnetic,
+		DriftKnots:         drift,
+	}, p.Err()
+}
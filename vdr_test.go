@@ -0,0 +1,51 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var vdrtests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  VDR
+}{
+	{
+		name: "good sentence",
+		raw:  "$IIVDR,10.1,T,12.3,M,1.2,N*3A",
+		msg: VDR{
+			SetDegreesTrue:     10.1,
+			SetDegreesMagnetic: 12.3,
+			DriftKnots:         1.2,
+		},
+	},
+	{
+		name: "invalid drift",
+		raw:  "$IIVDR,10.1,T,12.3,M,x.2,N*73",
+		err:  "nmea: IIVDR invalid drift: x.2",
+	},
+	{
+		name: "invalid drift unit",
+		raw:  "$IIVDR,10.1,T,12.3,M,1.2,K*3F",
+		err:  "nmea: IIVDR invalid drift unit: K",
+	},
+}
+
+func TestVDR(t *testing.T) {
+	for _, tt := range vdrtests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				vdr := m.(VDR)
+				vdr.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, vdr)
+			}
+		})
+	}
+}
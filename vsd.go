@@ -0,0 +1,77 @@
+package nmea
+
+import "fmt"
+
+const (
+	// TypeVSD type for VSD sentences
+	TypeVSD = "VSD"
+)
+
+// VSD is the AIS voyage static data. It is sent to an AIS unit to configure the
+// voyage related data, or by the unit to report it. A null field leaves the
+// configured value unchanged, so each numeric value has a flag telling whether it is set.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_vsd_ais_voyage_static_data
+type VSD struct {
+	BaseSentence
+	ShipCargo           int64   // Ship and cargo type, ITU-R M.1371
+	ShipCargoValid      bool    // Ship and cargo type is set
+	Draught             float64 // Maximum present static draught in meters
+	DraughtValid        bool    // Draught is set
+	PersonsOnBoard      int64   // Number of persons on board
+	PersonsOnBoardValid bool    // Number of persons on board is set
+	Destination         string  // Voyage destination
+	ETATime             Time    // Estimated UTC time of arrival
+	ETADay              int64   // Estimated day of arrival, 01 - 31
+	ETADayValid         bool    // Estimated day of arrival is set
+	ETAMonth            int64   // Estimated month of arrival, 01 - 12
+	ETAMonthValid       bool    // Estimated month of arrival is set
+	NavStatus           int64   // Navigational status, ITU-R M.1371
+	NavStatusValid      bool    // Navigational status is set
+	RegionalFlags       int64   // Regional application flags
+	RegionalFlagsValid  bool    // Regional application flags are set
+}
+
+// newVSD constructor
+func newVSD(s BaseSentence) (VSD, error) {
+	p := newParser(s)
+	p.AssertType(TypeVSD)
+	return VSD{
+		BaseSentence:        s,
+		ShipCargo:           p.Int64(0, "ship and cargo type"),
+		ShipCargoValid:      p.HasValue(0),
+		Draught:             p.Float64(1, "draught"),
+		DraughtValid:        p.HasValue(1),
+		PersonsOnBoard:      p.Int64(2, "persons on board"),
+		PersonsOnBoardValid: p.HasValue(2),
+		Destination:         p.String(3, "destination"),
+		ETATime:             p.Time(4, "ETA time"),
+		ETADay:              p.Int64(5, "ETA day"),
+		ETADayValid:         p.HasValue(5),
+		ETAMonth:            p.Int64(6, "ETA month"),
+		ETAMonthValid:       p.HasValue(6),
+		NavStatus:           p.Int64(7, "navigational status"),
+		NavStatusValid:      p.HasValue(7),
+		RegionalFlags:       p.Int64(8, "regional application flags"),
+		RegionalFlagsValid:  p.HasValue(8),
+	}, p.Err()
+}
+
+// Encode encodes the voyage static data into a VSD sentence using the talker of m.
+// Values that are not set are encoded as null fields.
+func (m VSD) Encode() string {
+	var eta string
+	if m.ETATime.Valid {
+		eta = fmt.Sprintf("%02d%02d%02d.%02d", m.ETATime.Hour, m.ETATime.Minute, m.ETATime.Second, m.ETATime.Millisecond/10)
+	}
+	return formatSentence(SentenceStart, m.Talker+TypeVSD, []string{
+		formatInt(m.ShipCargo, m.ShipCargoValid),
+		formatFloat(m.Draught, m.DraughtValid),
+		formatInt(m.PersonsOnBoard, m.PersonsOnBoardValid),
+		m.Destination,
+		eta,
+		formatInt(m.ETADay, m.ETADayValid),
+		formatInt(m.ETAMonth, m.ETAMonthValid),
+		formatInt(m.NavStatus, m.NavStatusValid),
+		formatInt(m.RegionalFlags, m.RegionalFlagsValid),
+	})
+}
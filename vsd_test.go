@@ -0,0 +1,76 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var vsdtests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  VSD
+}{
+	{
+		name: "good sentence",
+		raw:  "$AIVSD,70,8.5,24,HAMBURG,060000.00,16,10,0,0*2F",
+		msg: VSD{
+			ShipCargo:           70,
+			ShipCargoValid:      true,
+			Draught:             8.5,
+			DraughtValid:        true,
+			PersonsOnBoard:      24,
+			PersonsOnBoardValid: true,
+			Destination:         "HAMBURG",
+			ETATime:             Time{true, 6, 0, 0, 0, 0},
+			ETADay:              16,
+			ETADayValid:         true,
+			ETAMonth:            10,
+			ETAMonthValid:       true,
+			NavStatus:           0,
+			NavStatusValid:      true,
+			RegionalFlags:       0,
+			RegionalFlagsValid:  true,
+		},
+	},
+	{
+		name: "null fields",
+		raw:  "$AIVSD,70,,,HAMBURG,,,,5,*11",
+		msg: VSD{
+			ShipCargo:      70,
+			ShipCargoValid: true,
+			Destination:    "HAMBURG",
+			NavStatus:      5,
+			NavStatusValid: true,
+		},
+	},
+	{
+		name: "all null fields",
+		raw:  "$AIVSD,,,,,,,,,*65",
+		msg:  VSD{},
+	},
+	{
+		name: "invalid ETA day",
+		raw:  "$AIVSD,70,8.5,24,HAMBURG,060000.00,x,10,0,0*50",
+		err:  "nmea: AIVSD invalid ETA day: x",
+	},
+}
+
+func TestVSD(t *testing.T) {
+	for _, tt := range vsdtests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				vsd := m.(VSD)
+				assert.Equal(t, tt.raw, vsd.Encode())
+				vsd.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, vsd)
+			}
+		})
+	}
+}
@@ -9,22 +9,38 @@ const (
 // http://aprs.gids.nl/nmea/#vtg
 type VTG struct {
 	BaseSentence
-	TrueTrack        float64
-	MagneticTrack    float64
-	GroundSpeedKnots float64
-	GroundSpeedKPH   float64
+	TrueTrack        Bearing
+	MagneticTrack    Bearing
+	GroundSpeedKnots Speed   // Ground speed from the knots field, Speed is stored in knots
+	GroundSpeedKPH   float64 // Ground speed from the km/h field, in kilometers per hour
 }
 
+// vtgLayout is the field layout of VTG sentences.
+var vtgLayout = newLayout(VTG{}, TypeVTG, []int{8, 9},
+	layoutField{name: "TrueTrack", context: "true track", kind: bearingField},
+	layoutField{kind: unitField, options: []string{"T"}},
+	layoutField{name: "MagneticTrack", context: "magnetic track", kind: bearingField},
+	layoutField{kind: unitField, options: []string{"M"}},
+	layoutField{name: "GroundSpeedKnots", context: "ground speed (knots)", kind: floatField},
+	layoutField{kind: unitField, options: []string{"N"}},
+	layoutField{name: "GroundSpeedKPH", context: "ground speed (km/h)", kind: floatField},
+	layoutField{kind: unitField, options: []string{"K"}},
+)
+
 // newVTG parses the VTG sentence into this struct.
 // e.g: $GPVTG,360.0,T,348.7,M,000.0,N,000.0,K*43
 func newVTG(s BaseSentence) (VTG, error) {
-	p := newParser(s)
-	p.AssertType(TypeVTG)
-	return VTG{
-		BaseSentence:     s,
-		TrueTrack:        p.Float64(0, "true track"),
-		MagneticTrack:    p.Float64(2, "magnetic track"),
-		GroundSpeedKnots: p.Float64(4, "ground speed (knots)"),
-		GroundSpeedKPH:   p.Float64(6, "ground speed (km/h)"),
-	}, p.Err()
+	var m VTG
+	err := vtgLayout.parse(s, &m)
+	return m, err
+}
+
+// Encode encodes m into a VTG sentence using the talker of m.
+func (m VTG) Encode() string {
+	return vtgLayout.encode(m.Talker, m)
+}
+
+// GroundSpeedFromKPH returns the ground speed from the km/h field as a Speed.
+func (m VTG) GroundSpeedFromKPH() Speed {
+	return SpeedFromKilometersPerHour(m.GroundSpeedKPH)
 }
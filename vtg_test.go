@@ -45,3 +45,9 @@ func TestVTG(t *testing.T) {
 		})
 	}
 }
+
+func TestVTGGroundSpeedFromKPH(t *testing.T) {
+	m := VTG{GroundSpeedKPH: 56.4}
+	assert.InDelta(t, 56.4, m.GroundSpeedFromKPH().KilometersPerHour(), 1e-9)
+	assert.InDelta(t, 30.453564, m.GroundSpeedFromKPH().Knots(), 1e-6)
+}
@@ -0,0 +1,36 @@
+package nmea
+
+const (
+	// TypeWCV type for WCV sentences
+	TypeWCV = "WCV"
+)
+
+// WCV is the waypoint closure velocity, the component of the velocity vector
+// in the direction of the waypoint.
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_wcv_waypoint_closure_velocity
+type WCV struct {
+	BaseSentence
+	Velocity   float64 // Velocity component towards the waypoint in knots
+	WaypointID string  // Waypoint ID
+	FAAMode    string  // FAA mode indicator (NMEA 2.3 and later)
+}
+
+// newWCV constructor
+func newWCV(s BaseSentence) (WCV, error) {
+	p := newParser(s)
+	p.AssertType(TypeWCV)
+	p.AssertFieldCount(3, 4)
+
+	velocity := p.Float64(0, "velocity")
+	_ = p.EnumString(1, "velocity unit", SpeedKnots)
+
+	m := WCV{
+		BaseSentence: s,
+		Velocity:     velocity,
+		WaypointID:   p.String(2, "waypoint ID"),
+	}
+	if len(m.Fields) > 3 {
+		m.FAAMode = p.EnumString(3, "FAA mode", FAAModeAutonomous, FAAModeDifferential, FAAModeEstimated, FAAModeManual, FAAModeSimulator, FAAModeNotValid, FAAModePrecise)
+	}
+	return m, p.Err()
+}
@@ -0,0 +1,54 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var wcvtests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  WCV
+}{
+	{
+		name: "good sentence",
+		raw:  "$GPWCV,3.5,N,DEST,A*74",
+		msg: WCV{
+			Velocity:   3.5,
+			WaypointID: "DEST",
+			FAAMode:    FAAModeAutonomous,
+		},
+	},
+	{
+		name: "good sentence without FAA mode",
+		raw:  "$GPWCV,3.5,N,DEST*19",
+		msg: WCV{
+			Velocity:   3.5,
+			WaypointID: "DEST",
+		},
+	},
+	{
+		name: "invalid velocity unit",
+		raw:  "$GPWCV,3.5,K,DEST,A*71",
+		err:  "nmea: GPWCV invalid velocity unit: K",
+	},
+}
+
+func TestWCV(t *testing.T) {
+	for _, tt := range wcvtests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				wcv := m.(WCV)
+				wcv.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, wcv)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package nmea
+
+import (
+	"fmt"
+	"math"
+)
+
+// TrueWind is the wind relative to the water, computed from the apparent wind
+// measured on board and the motion of the vessel.
+type TrueWind struct {
+	Angle     float64 // Wind angle relative to the bow in degrees, positive to starboard, in the range (-180, 180]
+	Direction Bearing // Direction the wind blows from, relative to true north
+	Speed     Speed   // Wind speed
+}
+
+// ComputeTrueWind derives the true wind from the apparent wind angle relative to the bow
+// (e.g. from MWV), the apparent wind speed, the vessel speed through the water (e.g. from VHW)
+// and the true heading. Passing the speed and course over ground from RMC instead gives the
+// wind relative to the ground.
+func ComputeTrueWind(apparentAngle float64, apparentSpeed, vesselSpeed Speed, heading Bearing) TrueWind {
+	angle := apparentAngle * math.Pi / 180
+	x := float64(apparentSpeed)*math.Cos(angle) - float64(vesselSpeed)
+	y := float64(apparentSpeed) * math.Sin(angle)
+	w := TrueWind{Speed: Speed(math.Hypot(x, y))}
+	if w.Speed != 0 {
+		w.Angle = math.Atan2(y, x) * 180 / math.Pi
+	}
+	w.Direction = heading.Add(w.Angle)
+	return w
+}
+
+// MWD encodes the true wind as an MWD sentence with the given talker, using the
+// magnetic variation in degrees, positive to the east, for the magnetic direction.
+func (w TrueWind) MWD(talker string, variation float64) string {
+	return formatSentence(SentenceStart, talker+"MWD", []string{
+		fmt.Sprintf("%.1f", w.Direction.Degrees()), BearingTrue,
+		fmt.Sprintf("%.1f", MagneticFromTrue(w.Direction.Degrees(), variation)), BearingMagnetic,
+		fmt.Sprintf("%.1f", w.Speed.Knots()), SpeedKnots,
+		fmt.Sprintf("%.1f", w.Speed.MetersPerSecond()), "M",
+	})
+}
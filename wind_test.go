@@ -0,0 +1,75 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputeTrueWind(t *testing.T) {
+	var tests = []struct {
+		name          string
+		apparentAngle float64
+		apparentSpeed Speed
+		vesselSpeed   Speed
+		heading       Bearing
+		expected      TrueWind
+	}{
+		{
+			name:          "stationary",
+			apparentAngle: 45,
+			apparentSpeed: 10,
+			heading:       90,
+			expected:      TrueWind{Angle: 45, Direction: 135, Speed: 10},
+		},
+		{
+			name:          "head to wind",
+			apparentAngle: 0,
+			apparentSpeed: 15,
+			vesselSpeed:   5,
+			heading:       200,
+			expected:      TrueWind{Angle: 0, Direction: 200, Speed: 10},
+		},
+		{
+			name:          "apparent wind on the beam",
+			apparentAngle: 90,
+			apparentSpeed: 10,
+			vesselSpeed:   10,
+			heading:       350,
+			expected:      TrueWind{Angle: 135, Direction: 125, Speed: 14.142136},
+		},
+		{
+			name:          "apparent wind to port",
+			apparentAngle: -90,
+			apparentSpeed: 10,
+			vesselSpeed:   10,
+			heading:       10,
+			expected:      TrueWind{Angle: -135, Direction: 235, Speed: 14.142136},
+		},
+		{
+			name:          "no true wind",
+			apparentAngle: 0,
+			apparentSpeed: 5,
+			vesselSpeed:   5,
+			heading:       30,
+			expected:      TrueWind{Angle: 0, Direction: 30, Speed: 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := ComputeTrueWind(tt.apparentAngle, tt.apparentSpeed, tt.vesselSpeed, tt.heading)
+			assert.InDelta(t, tt.expected.Angle, w.Angle, 1e-6)
+			assert.InDelta(t, tt.expected.Direction.Degrees(), w.Direction.Degrees(), 1e-6)
+			assert.InDelta(t, tt.expected.Speed.Knots(), w.Speed.Knots(), 1e-6)
+		})
+	}
+}
+
+func TestTrueWindMWD(t *testing.T) {
+	w := TrueWind{Angle: 45, Direction: 135, Speed: 10}
+	raw := w.MWD("WI", -2)
+	assert.Equal(t, "$WIMWD,135.0,T,137.0,M,10.0,N,5.1,M*6D", raw)
+	s, err := parseSentence(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, "MWD", s.Type)
+}
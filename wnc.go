@@ -0,0 +1,36 @@
+package nmea
+
+const (
+	// TypeWNC type for WNC sentences
+	TypeWNC = "WNC"
+)
+
+// WNC is the distance from one waypoint to another.
+// http://aprs.gids.nl/nmea/#wnc
+type WNC struct {
+	BaseSentence
+	DistanceNauticalMiles float64 // Distance in nautical miles
+	DistanceKilometers    float64 // Distance in kilometers
+	ToWaypointID          string  // TO waypoint ID
+	FromWaypointID        string  // FROM waypoint ID
+}
+
+// newWNC constructor
+func newWNC(s BaseSentence) (WNC, error) {
+	p := newParser(s)
+	p.AssertType(TypeWNC)
+
+	nauticalMiles := p.Float64(0, "distance (nautical miles)")
+	_ = p.EnumString(1, "distance unit (nautical miles)", DistanceNauticalMiles)
+
+	kilometers := p.Float64(2, "distance (kilometers)")
+	_ = p.EnumString(3, "distance unit (kilometers)", DistanceKilometers)
+
+	return WNC{
+		BaseSentence:          s,
+		DistanceNauticalMiles: nauticalMiles,
+		DistanceKilometers:    kilometers,
+		ToWaypointID:          p.String(4, "to waypoint ID"),
+		FromWaypointID:        p.String(5, "from waypoint ID"),
+	}, p.Err()
+}
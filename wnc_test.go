@@ -0,0 +1,57 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var wnctests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  WNC
+}{
+	{
+		name: "good sentence",
+		raw:  "$GPWNC,200.00,N,370.40,K,Dest,Origin*58",
+		msg: WNC{
+			DistanceNauticalMiles: 200,
+			DistanceKilometers:    370.4,
+			ToWaypointID:          "Dest",
+			FromWaypointID:        "Origin",
+		},
+	},
+	{
+		name: "invalid nautical miles unit",
+		raw:  "$GPWNC,200.00,X,370.40,K,Dest,Origin*4E",
+		err:  "nmea: GPWNC invalid distance unit (nautical miles): X",
+	},
+	{
+		name: "invalid kilometers",
+		raw:  "$GPWNC,200.00,N,3x0.40,K,Dest,Origin*17",
+		err:  "nmea: GPWNC invalid distance (kilometers): 3x0.40",
+	},
+	{
+		name: "invalid kilometers unit",
+		raw:  "$GPWNC,200.00,N,370.40,X,Dest,Origin*4B",
+		err:  "nmea: GPWNC invalid distance unit (kilometers): X",
+	},
+}
+
+func TestWNC(t *testing.T) {
+	for _, tt := range wnctests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				wnc := m.(WNC)
+				wnc.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, wnc)
+			}
+		})
+	}
+}
@@ -8,9 +8,9 @@ const (
 // WPL contains information about a waypoint location
 type WPL struct {
 	BaseSentence
-	Latitude  float64 // Latitude
-	Longitude float64 // Longitude
-	Ident     string  // Ident of nth waypoint
+	Latitude  Latitude  // Latitude
+	Longitude Longitude // Longitude
+	Ident     string    // Ident of nth waypoint
 }
 
 // newWPL constructor
@@ -19,8 +19,13 @@ func newWPL(s BaseSentence) (WPL, error) {
 	p.AssertType(TypeWPL)
 	return WPL{
 		BaseSentence: s,
-		Latitude:     p.LatLong(0, 1, "latitude"),
-		Longitude:    p.LatLong(2, 3, "longitude"),
+		Latitude:     p.Latitude(0, 1, "latitude"),
+		Longitude:    p.Longitude(2, 3, "longitude"),
 		Ident:        p.String(4, "ident of nth waypoint"),
 	}, p.Err()
 }
+
+// Position returns the reported position.
+func (m WPL) Position() Position {
+	return Position{Latitude: m.Latitude, Longitude: m.Longitude}
+}
@@ -0,0 +1,146 @@
+package nmea
+
+import "fmt"
+
+const (
+	// TypeXDR type for XDR sentences
+	TypeXDR = "XDR"
+	// TransducerAngularXDR angular displacement transducer
+	TransducerAngularXDR = "A"
+	// TransducerTemperatureXDR temperature transducer
+	TransducerTemperatureXDR = "C"
+	// TransducerLinearXDR linear displacement transducer
+	TransducerLinearXDR = "D"
+	// TransducerFrequencyXDR frequency transducer
+	TransducerFrequencyXDR = "F"
+	// TransducerGenericXDR generic transducer
+	TransducerGenericXDR = "G"
+	// TransducerHumidityXDR humidity transducer
+	TransducerHumidityXDR = "H"
+	// TransducerCurrentXDR current transducer
+	TransducerCurrentXDR = "I"
+	// TransducerForceXDR force transducer
+	TransducerForceXDR = "N"
+	// TransducerPressureXDR pressure transducer
+	TransducerPressureXDR = "P"
+	// TransducerFlowRateXDR flow rate transducer
+	TransducerFlowRateXDR = "R"
+	// TransducerSwitchXDR switch or valve transducer
+	TransducerSwitchXDR = "S"
+	// TransducerTachometerXDR tachometer transducer
+	TransducerTachometerXDR = "T"
+	// TransducerVoltageXDR voltage transducer
+	TransducerVoltageXDR = "U"
+	// TransducerVolumeXDR volume transducer
+	TransducerVolumeXDR = "V"
+	// UnitDegreesXDR degrees unit of angular displacement transducers
+	UnitDegreesXDR = "D"
+	// UnitCelsiusXDR degrees Celsius unit of temperature transducers
+	UnitCelsiusXDR = "C"
+	// UnitMetersXDR meters unit of linear displacement transducers
+	UnitMetersXDR = "M"
+	// UnitHertzXDR hertz unit of frequency transducers
+	UnitHertzXDR = "H"
+	// UnitPercentXDR percent unit of humidity transducers
+	UnitPercentXDR = "P"
+	// UnitAmperesXDR amperes unit of current transducers
+	UnitAmperesXDR = "A"
+	// UnitNewtonsXDR newtons unit of force transducers
+	UnitNewtonsXDR = "N"
+	// UnitPascalsXDR pascals unit of pressure transducers
+	UnitPascalsXDR = "P"
+	// UnitBarsXDR bars unit of pressure transducers
+	UnitBarsXDR = "B"
+	// UnitLitersPerSecondXDR liters per second unit of flow rate transducers
+	UnitLitersPerSecondXDR = "L"
+	// UnitRPMXDR revolutions per minute unit of tachometer transducers
+	UnitRPMXDR = "R"
+	// UnitVoltsXDR volts unit of voltage transducers
+	UnitVoltsXDR = "V"
+	// UnitCubicMetersXDR cubic meters unit of volume transducers
+	UnitCubicMetersXDR = "M"
+)
+
+// XDR is the transducer measurements
+// https://gpsd.gitlab.io/gpsd/NMEA.html#_xdr_transducer_measurement
+type XDR struct {
+	BaseSentence
+	Measurements []XDRMeasurement // Transducer measurements
+}
+
+// XDRMeasurement is a single transducer measurement
+type XDRMeasurement struct {
+	TransducerType string  // Transducer type, e.g. A-angular, C-temperature, P-pressure, U-voltage
+	Value          float64 // Measurement value
+	Unit           string  // Unit of the value, depends on the transducer type
+	Name           string  // Transducer name
+}
+
+// newXDR constructor
+func newXDR(s BaseSentence) (XDR, error) {
+	p := newParser(s)
+	p.AssertType(TypeXDR)
+	m := XDR{BaseSentence: s}
+	if len(m.Fields)%4 != 0 {
+		p.SetErr("number of fields", fmt.Sprint(len(m.Fields)))
+		return m, p.Err()
+	}
+	for i := 0; i < len(m.Fields); i += 4 {
+		m.Measurements = append(m.Measurements, XDRMeasurement{
+			TransducerType: p.String(i, "transducer type"),
+			Value:          p.Float64(i+1, "measurement value"),
+			Unit:           p.String(i+2, "measurement unit"),
+			Name:           p.String(i+3, "transducer name"),
+		})
+	}
+	return m, p.Err()
+}
+
+// Measurement returns the measurement of the named transducer.
+func (m XDR) Measurement(name string) (XDRMeasurement, bool) {
+	for _, v := range m.Measurements {
+		if v.Name == name {
+			return v, true
+		}
+	}
+	return XDRMeasurement{}, false
+}
+
+// Degrees returns the value of an angular displacement measurement in degrees.
+func (m XDRMeasurement) Degrees() (float64, error) {
+	return m.convert(TransducerAngularXDR, "angular displacement", map[string]float64{UnitDegreesXDR: 1})
+}
+
+// Temperature returns the value of a temperature measurement.
+func (m XDRMeasurement) Temperature() (Temperature, error) {
+	v, err := m.convert(TransducerTemperatureXDR, "temperature", map[string]float64{UnitCelsiusXDR: 1})
+	return TemperatureFromCelsius(v), err
+}
+
+// Pressure returns the value of a pressure measurement.
+func (m XDRMeasurement) Pressure() (Pressure, error) {
+	v, err := m.convert(TransducerPressureXDR, "pressure", map[string]float64{UnitPascalsXDR: 1, UnitBarsXDR: pascalsPerBar})
+	return PressureFromPascals(v), err
+}
+
+// Volts returns the value of a voltage measurement in volts.
+func (m XDRMeasurement) Volts() (float64, error) {
+	return m.convert(TransducerVoltageXDR, "voltage", map[string]float64{UnitVoltsXDR: 1})
+}
+
+// Amperes returns the value of a current measurement in amperes.
+func (m XDRMeasurement) Amperes() (float64, error) {
+	return m.convert(TransducerCurrentXDR, "current", map[string]float64{UnitAmperesXDR: 1})
+}
+
+// convert scales the value by the factor of its unit, after checking the transducer type.
+func (m XDRMeasurement) convert(transducer, quantity string, factors map[string]float64) (float64, error) {
+	if m.TransducerType != transducer {
+		return 0, fmt.Errorf("nmea: XDR measurement %s invalid transducer type for %s: %s", m.Name, quantity, m.TransducerType)
+	}
+	factor, ok := factors[m.Unit]
+	if !ok {
+		return 0, fmt.Errorf("nmea: XDR measurement %s invalid %s unit: %s", m.Name, quantity, m.Unit)
+	}
+	return m.Value * factor, nil
+}
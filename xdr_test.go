@@ -0,0 +1,95 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var xdrtests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  XDR
+}{
+	{
+		name: "good sentence",
+		raw:  "$IIXDR,A,-1.5,D,PITCH,A,2.3,D,ROLL*3D",
+		msg: XDR{
+			Measurements: []XDRMeasurement{
+				{TransducerType: TransducerAngularXDR, Value: -1.5, Unit: UnitDegreesXDR, Name: "PITCH"},
+				{TransducerType: TransducerAngularXDR, Value: 2.3, Unit: UnitDegreesXDR, Name: "ROLL"},
+			},
+		},
+	},
+	{
+		name: "invalid number of fields",
+		raw:  "$IIXDR,C,19.52,C*43",
+		err:  "nmea: IIXDR invalid number of fields: 3",
+	},
+	{
+		name: "invalid measurement value",
+		raw:  "$IIXDR,C,x,C,TempAir*40",
+		err:  "nmea: IIXDR invalid measurement value: x",
+	},
+}
+
+func TestXDR(t *testing.T) {
+	for _, tt := range xdrtests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				xdr := m.(XDR)
+				xdr.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, xdr)
+			}
+		})
+	}
+}
+
+func TestXDRMeasurement(t *testing.T) {
+	s, err := Parse("$IIXDR,C,19.52,C,TempAir,P,1.02481,B,Barometer,U,12.6,V,Battery*2B")
+	assert.NoError(t, err)
+	m := s.(XDR)
+
+	temperature, ok := m.Measurement("TempAir")
+	assert.True(t, ok)
+	celsius, err := temperature.Temperature()
+	assert.NoError(t, err)
+	assert.Equal(t, 19.52, celsius.Celsius())
+	_, err = temperature.Pressure()
+	assert.EqualError(t, err, "nmea: XDR measurement TempAir invalid transducer type for pressure: C")
+
+	pressure, ok := m.Measurement("Barometer")
+	assert.True(t, ok)
+	barometer, err := pressure.Pressure()
+	assert.NoError(t, err)
+	assert.InDelta(t, 1024.81, barometer.Hectopascals(), 1e-6)
+
+	battery, ok := m.Measurement("Battery")
+	assert.True(t, ok)
+	volts, err := battery.Volts()
+	assert.NoError(t, err)
+	assert.Equal(t, 12.6, volts)
+
+	_, ok = m.Measurement("Depth")
+	assert.False(t, ok)
+
+	s, err = Parse("$IIXDR,P,101325,P,Baro,I,3.5,A,Load,C,19.5,F,TempF*0E")
+	assert.NoError(t, err)
+	m = s.(XDR)
+	barometer, err = m.Measurements[0].Pressure()
+	assert.NoError(t, err)
+	assert.InDelta(t, 101325, barometer.Pascals(), 1e-6)
+	amperes, err := m.Measurements[1].Amperes()
+	assert.NoError(t, err)
+	assert.Equal(t, 3.5, amperes)
+	_, err = m.Measurements[2].Temperature()
+	assert.EqualError(t, err, "nmea: XDR measurement TempF invalid temperature unit: F")
+	_, err = m.Measurements[2].Degrees()
+	assert.EqualError(t, err, "nmea: XDR measurement TempF invalid transducer type for angular displacement: C")
+}
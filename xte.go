@@ -0,0 +1,41 @@
+package nmea
+
+const (
+	// TypeXTE type for XTE sentences
+	TypeXTE = "XTE"
+	// ValidXTE character
+	ValidXTE = "A"
+	// InvalidXTE character
+	InvalidXTE = "V"
+)
+
+// XTE is the measured cross-track error.
+// http://aprs.gids.nl/nmea/#xte
+type XTE struct {
+	BaseSentence
+	StatusGeneral   string  // General warning flag (Loran-C blink or SNR) - A-ok, V-warning
+	StatusLock      string  // Loran-C cycle lock warning flag - A-ok, V-warning
+	CrossTrackError float64 // Cross-track error magnitude
+	SteerDirection  string  // Direction to steer, L or R
+	Units           string  // Cross-track error units, N for nautical miles
+	FAAMode         string  // FAA mode indicator (NMEA 2.3 and later)
+}
+
+// newXTE constructor
+func newXTE(s BaseSentence) (XTE, error) {
+	p := newParser(s)
+	p.AssertType(TypeXTE)
+	p.AssertFieldCount(5, 6)
+	m := XTE{
+		BaseSentence:    s,
+		StatusGeneral:   p.EnumString(0, "general status", ValidXTE, InvalidXTE),
+		StatusLock:      p.EnumString(1, "lock status", ValidXTE, InvalidXTE),
+		CrossTrackError: p.Float64(2, "cross-track error"),
+		SteerDirection:  p.EnumString(3, "steer direction", Left, Right),
+		Units:           p.EnumString(4, "cross-track error units", DistanceNauticalMiles),
+	}
+	if len(m.Fields) > 5 {
+		m.FAAMode = p.EnumString(5, "FAA mode", FAAModeAutonomous, FAAModeDifferential, FAAModeEstimated, FAAModeManual, FAAModeSimulator, FAAModeNotValid, FAAModePrecise)
+	}
+	return m, p.Err()
+}
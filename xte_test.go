@@ -0,0 +1,73 @@
+package nmea
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var xtetests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  XTE
+}{
+	{
+		name: "good sentence",
+		raw:  "$GPXTE,A,A,0.67,L,N*6F",
+		msg: XTE{
+			StatusGeneral:   ValidXTE,
+			StatusLock:      ValidXTE,
+			CrossTrackError: 0.67,
+			SteerDirection:  Left,
+			Units:           DistanceNauticalMiles,
+		},
+	},
+	{
+		name: "empty sentence with FAA mode",
+		raw:  "$GPXTE,V,V,,,N,S*43",
+		msg: XTE{
+			StatusGeneral: InvalidXTE,
+			StatusLock:    InvalidXTE,
+			Units:         DistanceNauticalMiles,
+			FAAMode:       FAAModeSimulator,
+		},
+	},
+	{
+		name: "invalid general status",
+		raw:  "$GPXTE,X,A,0.67,L,N*76",
+		err:  "nmea: GPXTE invalid general status: X",
+	},
+	{
+		name: "invalid steer direction",
+		raw:  "$GPXTE,A,A,0.67,X,N*7B",
+		err:  "nmea: GPXTE invalid steer direction: X",
+	},
+	{
+		name: "invalid units",
+		raw:  "$GPXTE,A,A,0.67,L,K*6A",
+		err:  "nmea: GPXTE invalid cross-track error units: K",
+	},
+	{
+		name: "invalid FAA mode",
+		raw:  "$GPXTE,A,A,0.67,L,N,X*1B",
+		err:  "nmea: GPXTE invalid FAA mode: X",
+	},
+}
+
+func TestXTE(t *testing.T) {
+	for _, tt := range xtetests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				xte := m.(XTE)
+				xte.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, xte)
+			}
+		})
+	}
+}
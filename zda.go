@@ -1,5 +1,7 @@
 package nmea
 
+import "time"
+
 const (
 	// TypeZDA type for ZDA sentences
 	TypeZDA = "ZDA"
@@ -17,17 +19,30 @@ type ZDA struct {
 	OffsetMinutes int64 // Local time zone offset from GMT, minutes
 }
 
+// zdaLayout is the field layout of ZDA sentences.
+var zdaLayout = newLayout(ZDA{}, TypeZDA, []int{6},
+	layoutField{name: "Time", context: "time", kind: timeField},
+	layoutField{name: "Day", context: "day", kind: intField},
+	layoutField{name: "Month", context: "month", kind: intField},
+	layoutField{name: "Year", context: "year", kind: intField},
+	layoutField{name: "OffsetHours", context: "offset (hours)", kind: intField},
+	layoutField{name: "OffsetMinutes", context: "offset (minutes)", kind: intField},
+)
+
 // newZDA constructor
 func newZDA(s BaseSentence) (ZDA, error) {
-	p := newParser(s)
-	p.AssertType(TypeZDA)
-	return ZDA{
-		BaseSentence:  s,
-		Time:          p.Time(0, "time"),
-		Day:           p.Int64(1, "day"),
-		Month:         p.Int64(2, "month"),
-		Year:          p.Int64(3, "year"),
-		OffsetHours:   p.Int64(4, "offset (hours)"),
-		OffsetMinutes: p.Int64(5, "offset (minutes)"),
-	}, p.Err()
+	var m ZDA
+	err := zdaLayout.parse(s, &m)
+	return m, err
+}
+
+// Encode encodes m into a ZDA sentence using the talker of m.
+func (m ZDA) Encode() string {
+	return zdaLayout.encode(m.Talker, m)
+}
+
+// DateTime returns the date and time in the given location, UTC if nil.
+func (m ZDA) DateTime(loc *time.Location) time.Time {
+	d := Date{Valid: m.Time.Valid, DD: int(m.Day), MM: int(m.Month), YY: int(m.Year)}
+	return DateTime(loc, d, m.Time)
 }
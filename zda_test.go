@@ -2,6 +2,7 @@ package nmea
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -32,9 +33,14 @@ var zdatests = []struct {
 	},
 	{
 		name: "invalid day",
-		raw:  "$GPZDA,220516,D,5133.82,N,00042.24,W,173.8,231.8,130694,004.2,W*76",
+		raw:  "$GPZDA,172809.456,D,07,1996,00,00*10",
 		err:  "nmea: GPZDA invalid day: D",
 	},
+	{
+		name: "invalid number of fields",
+		raw:  "$GPZDA,220516,D,5133.82,N,00042.24,W,173.8,231.8,130694,004.2,W*76",
+		err:  "nmea: GPZDA invalid number of fields: 11",
+	},
 }
 
 func TestZDA(t *testing.T) {
@@ -53,3 +59,10 @@ func TestZDA(t *testing.T) {
 		})
 	}
 }
+
+func TestZDADateTime(t *testing.T) {
+	m, err := Parse("$GPZDA,172809.456,12,07,1996,00,00*57")
+	assert.NoError(t, err)
+	expected := time.Date(1996, time.July, 12, 17, 28, 9, 456*int(time.Millisecond), time.UTC)
+	assert.Equal(t, expected, m.(ZDA).DateTime(nil))
+}
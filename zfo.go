@@ -0,0 +1,29 @@
+package nmea
+
+import "time"
+
+const (
+	// TypeZFO type for ZFO sentences
+	TypeZFO = "ZFO"
+)
+
+// ZFO is the UTC and elapsed time from the origin waypoint.
+// http://aprs.gids.nl/nmea/#zfo
+type ZFO struct {
+	BaseSentence
+	Time             Time          // UTC time
+	ElapsedTime      time.Duration // Elapsed time from origin waypoint
+	OriginWaypointID string        // Origin waypoint ID
+}
+
+// newZFO constructor
+func newZFO(s BaseSentence) (ZFO, error) {
+	p := newParser(s)
+	p.AssertType(TypeZFO)
+	return ZFO{
+		BaseSentence:     s,
+		Time:             p.Time(0, "time"),
+		ElapsedTime:      p.Duration(1, "elapsed time"),
+		OriginWaypointID: p.String(2, "origin waypoint ID"),
+	}, p.Err()
+}
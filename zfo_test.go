@@ -0,0 +1,52 @@
+package nmea
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var zfotests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  ZFO
+}{
+	{
+		name: "good sentence",
+		raw:  "$GPZFO,145832.12,042359.17,WPT*3E",
+		msg: ZFO{
+			Time:             Time{true, 14, 58, 32, 120, 0},
+			ElapsedTime:      4*time.Hour + 23*time.Minute + 59*time.Second + 170*time.Millisecond,
+			OriginWaypointID: "WPT",
+		},
+	},
+	{
+		name: "invalid time",
+		raw:  "$GPZFO,1458x2.12,042359.17,WPT*75",
+		err:  "nmea: GPZFO invalid time: 1458x2.12",
+	},
+	{
+		name: "invalid elapsed time",
+		raw:  "$GPZFO,145832.12,0423x9.17,WPT*73",
+		err:  "nmea: GPZFO invalid elapsed time: 0423x9.17",
+	},
+}
+
+func TestZFO(t *testing.T) {
+	for _, tt := range zfotests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				zfo := m.(ZFO)
+				zfo.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, zfo)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package nmea
+
+import "time"
+
+const (
+	// TypeZTG type for ZTG sentences
+	TypeZTG = "ZTG"
+)
+
+// ZTG is the UTC and estimated time to go to the destination waypoint.
+// http://aprs.gids.nl/nmea/#ztg
+type ZTG struct {
+	BaseSentence
+	Time                  Time          // UTC time
+	TimeToGo              time.Duration // Time to go to destination waypoint
+	DestinationWaypointID string        // Destination waypoint ID
+}
+
+// newZTG constructor
+func newZTG(s BaseSentence) (ZTG, error) {
+	p := newParser(s)
+	p.AssertType(TypeZTG)
+	return ZTG{
+		BaseSentence:          s,
+		Time:                  p.Time(0, "time"),
+		TimeToGo:              p.Duration(1, "time to go"),
+		DestinationWaypointID: p.String(2, "destination waypoint ID"),
+	}, p.Err()
+}
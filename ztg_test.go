@@ -0,0 +1,54 @@
+package nmea
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var ztgtests = []struct {
+	name string
+	raw  string
+	err  string
+	msg  ZTG
+}{
+	{
+		name: "good sentence",
+		raw:  "$GPZTG,145832.12,042359.17,WPT*24",
+		msg: ZTG{
+			Time:                  Time{true, 14, 58, 32, 120, 0},
+			TimeToGo:              4*time.Hour + 23*time.Minute + 59*time.Second + 170*time.Millisecond,
+			DestinationWaypointID: "WPT",
+		},
+	},
+	{
+		name: "empty times",
+		raw:  "$GPZTG,,,WPT*21",
+		msg: ZTG{
+			DestinationWaypointID: "WPT",
+		},
+	},
+	{
+		name: "invalid time to go",
+		raw:  "$GPZTG,145832.12,0423x9.17,WPT*69",
+		err:  "nmea: GPZTG invalid time to go: 0423x9.17",
+	},
+}
+
+func TestZTG(t *testing.T) {
+	for _, tt := range ztgtests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := Parse(tt.raw)
+			if tt.err != "" {
+				assert.Error(t, err)
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+				ztg := m.(ZTG)
+				ztg.BaseSentence = BaseSentence{}
+				assert.Equal(t, tt.msg, ztg)
+			}
+		})
+	}
+}